@@ -115,7 +115,7 @@ func (y *yielder[_]) cramChunks() {
 	b := make([]byte, y.cl)
 	for _, p := range y.p {
 		s := []byte(p.Chunk)
-		pos := bytes.Index(b, s)
+		pos := bytes.Index(b[:t], s)
 		if pos == -1 {
 			pos = t
 			t += copy(b[t:], s)
@@ -73,6 +73,54 @@ var doDefaultTests = []struct {
 			N:     []N[Default]{},
 		},
 	},
+	{
+		tree: sapling.New("\x00", "a"),
+		result: A[Default]{
+			C:     "\x00a",
+			Cml:   1,
+			Cma:   2,
+			Dclpm: 1,
+			Vm:    1,
+			N: []N[Default]{
+				{
+					HasValue:     false,
+					ChunkFirst:   0,
+					ChunkEmpty:   true,
+					Index:        0,
+					Chunk:        "",
+					Value:        0,
+					Parent:       0,
+					ChildrenLow:  1,
+					ChildrenHigh: 3,
+					ChunkPos:     0,
+				},
+				{
+					HasValue:     true,
+					ChunkFirst:   0,
+					ChunkEmpty:   false,
+					Index:        1,
+					Chunk:        "\x00",
+					Value:        0,
+					Parent:       0,
+					ChildrenLow:  0,
+					ChildrenHigh: 0,
+					ChunkPos:     0,
+				},
+				{
+					HasValue:     true,
+					ChunkFirst:   'a',
+					ChunkEmpty:   false,
+					Index:        2,
+					Chunk:        "a",
+					Value:        1,
+					Parent:       0,
+					ChildrenLow:  0,
+					ChildrenHigh: 0,
+					ChunkPos:     1,
+				},
+			},
+		},
+	},
 	{
 		tree: atree,
 		result: A[Default]{
@@ -429,6 +477,54 @@ var doFirstlessTests = []struct {
 			N:     []N[Firstless]{},
 		},
 	},
+	{
+		tree: sapling.New("a\x00", "b"),
+		result: A[Firstless]{
+			C:     "\x00",
+			Cml:   1,
+			Cma:   2,
+			Dclpm: 1,
+			Vm:    1,
+			N: []N[Firstless]{
+				{
+					HasValue:     false,
+					ChunkFirst:   0,
+					ChunkEmpty:   true,
+					Index:        0,
+					Chunk:        "",
+					Value:        0,
+					Parent:       0,
+					ChildrenLow:  1,
+					ChildrenHigh: 3,
+					ChunkPos:     0,
+				},
+				{
+					HasValue:     true,
+					ChunkFirst:   'a',
+					ChunkEmpty:   false,
+					Index:        1,
+					Chunk:        "\x00",
+					Value:        0,
+					Parent:       0,
+					ChildrenLow:  0,
+					ChildrenHigh: 0,
+					ChunkPos:     0,
+				},
+				{
+					HasValue:     true,
+					ChunkFirst:   'b',
+					ChunkEmpty:   false,
+					Index:        2,
+					Chunk:        "",
+					Value:        1,
+					Parent:       0,
+					ChildrenLow:  0,
+					ChildrenHigh: 0,
+					ChunkPos:     0,
+				},
+			},
+		},
+	},
 	{
 		tree: empty,
 		result: A[Firstless]{
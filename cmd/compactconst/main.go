@@ -0,0 +1,122 @@
+// Command compactconst generates Go source code, which declares compactified
+// radix tree, built from the provided file with keys and values, as Go
+// constant. Every line of the file is a key, optionally followed by tab
+// character and value of the key. If the value is omitted, index of the line
+// is used instead. The command is aimed to be used with go generate, for
+// example:
+//
+//	//go:generate go run github.com/alex-ilchukov/radixt/cmd/compactconst -in headers.txt -name Headers
+//
+// The command reports the picked implementation to standard error output.
+//
+// Flags:
+//
+//	-impl string
+//		implementation to try first: strg2, str3, strg3, str4, or
+//		strg4 (default "strg2")
+//	-in string
+//		path to file with keys and values (required)
+//	-name string
+//		name of generated tree constant (required)
+//	-out string
+//		path to generated file (default is lowercased name with
+//		_compact.go suffix)
+//	-pkg string
+//		package name of generated code (default is $GOPACKAGE)
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/alex-ilchukov/radixt/compact/literal"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+func loadSV(path string) (sv []sapling.SV, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+
+	for i := uint(0); scanner.Scan(); i++ {
+		e := sapling.SV{S: scanner.Text(), V: i}
+		if k, v, found := strings.Cut(e.S, "\t"); found {
+			v64, err := strconv.ParseUint(v, 0, 0)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+
+			e = sapling.SV{S: k, V: uint(v64)}
+		}
+
+		sv = append(sv, e)
+	}
+
+	err = scanner.Err()
+
+	return
+}
+
+func run() error {
+	in := flag.String("in", "", "path to file with keys and values")
+	out := flag.String("out", "", "path to generated file")
+	pkg := flag.String(
+		"pkg",
+		os.Getenv("GOPACKAGE"),
+		"package name of generated code",
+	)
+	name := flag.String("name", "", "name of generated tree constant")
+	implName := flag.String(
+		"impl",
+		"strg2",
+		"implementation to try first: strg2, str3, strg3, str4, or "+
+			"strg4",
+	)
+	flag.Parse()
+
+	if *in == "" || *name == "" {
+		return fmt.Errorf("-in and -name flags are required")
+	}
+
+	if *out == "" {
+		*out = strings.ToLower(*name) + "_compact.go"
+	}
+
+	impl, err := literal.ParseImpl(*implName)
+	if err != nil {
+		return err
+	}
+
+	sv, err := loadSV(*in)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	o := literal.Options{Package: *pkg, Name: *name, Impl: impl}
+	impl, err = literal.Generate(&b, sv, o)
+	if err != nil {
+		return fmt.Errorf("%s: %w", impl, err)
+	}
+
+	fmt.Fprintf(os.Stderr, "compactconst: %s is %s\n", *name, impl)
+
+	return os.WriteFile(*out, b.Bytes(), 0o644)
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "compactconst: %s\n", err)
+		os.Exit(1)
+	}
+}
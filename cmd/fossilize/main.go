@@ -0,0 +1,112 @@
+// Command fossilize generates Go source code, which hard-codes lookup process
+// in radix tree, built from lines of the provided file. Values of the keys are
+// indices of the lines, as in [sapling.New]. The command is aimed to be used
+// with go generate, for example:
+//
+//	//go:generate go run github.com/alex-ilchukov/radixt/cmd/fossilize -in methods.txt -type Methods -test
+//
+// Flags:
+//
+//	-in string
+//		path to file with keys, one key per line (required)
+//	-out string
+//		path to generated file (default is lowercased type name with
+//		_fossil.go suffix)
+//	-pkg string
+//		package name of generated code (default is $GOPACKAGE)
+//	-test
+//		generate also test file with _test.go suffix instead of .go
+//	-type string
+//		name of generated lookup type (default "Lookup")
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/fossil"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+func loadLines(path string) (lines []string, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	err = scanner.Err()
+
+	return
+}
+
+type generate func(io.Writer, radixt.Tree, fossil.Options) error
+
+func write(path string, g generate, t radixt.Tree, o fossil.Options) error {
+	var b bytes.Buffer
+	if err := g(&b, t, o); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b.Bytes(), 0o644)
+}
+
+func run() error {
+	in := flag.String("in", "", "path to file with keys, one key per line")
+	out := flag.String("out", "", "path to generated file")
+	pkg := flag.String(
+		"pkg",
+		os.Getenv("GOPACKAGE"),
+		"package name of generated code",
+	)
+	ty := flag.String("type", "Lookup", "name of generated lookup type")
+	test := flag.Bool("test", false, "generate also test file")
+	flag.Parse()
+
+	if *in == "" {
+		return fmt.Errorf("-in flag is required")
+	}
+
+	if *out == "" {
+		*out = strings.ToLower(*ty) + "_fossil.go"
+	}
+
+	lines, err := loadLines(*in)
+	if err != nil {
+		return err
+	}
+
+	t := sapling.New(lines...)
+	o := fossil.Options{Package: *pkg, Type: *ty}
+	if err = write(*out, fossil.Generate, t, o); err != nil {
+		return err
+	}
+
+	if !*test {
+		return nil
+	}
+
+	path := strings.TrimSuffix(*out, ".go") + "_test.go"
+
+	return write(path, fossil.GenerateTest, t, o)
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "fossilize: %s\n", err)
+		os.Exit(1)
+	}
+}
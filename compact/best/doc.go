@@ -0,0 +1,14 @@
+// Package best picks the compact implementation of radix tree, which would
+// take the least memory for the provided tree.
+//
+// The package analyzes the tree at most once per mode of analysis, which the
+// implementations need, and computes exact amount of bytes, which every
+// implementation would hoard, without creating the trees. Only the picked
+// implementation is created then. The candidates can be restricted with
+// [Options], and every rejected candidate is reported with the reason of the
+// rejection. All the candidates implement Switcher interface of package lookup,
+// so the picked tree always does.
+//
+// The package is a separate one, as the parent compact package is imported by
+// the implementations, so it can not import them in turn.
+package best
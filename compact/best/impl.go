@@ -0,0 +1,203 @@
+package best
+
+import (
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/analysis"
+	"github.com/alex-ilchukov/radixt/compact/internal/node"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/compact/struct32"
+	"github.com/alex-ilchukov/radixt/compact/struct64"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+)
+
+// Impl represents a compact implementation of radix tree.
+type Impl int
+
+// None is not an implementation. It is returned by [Pick] with [ErrorNone],
+// when no implementation fits the tree.
+const None Impl = -1
+
+// The implementations are ordered by size of their nodes. If several
+// implementations would take the same amount of memory, the first one is
+// picked.
+const (
+	StrgN2 Impl = iota
+	Str3
+	StrgN3
+	Str4
+	StrgN4
+	Struct32
+	StructgUint32
+	Struct64
+	StructgUint64
+	implsAmount
+)
+
+// analyses holds results of analysis of tree t in both modes. Every result is
+// computed only once, when it is requested for the first time.
+type analyses struct {
+	t radixt.Tree
+	d *analysis.A[analysis.Default]
+	f *analysis.A[analysis.Firstless]
+}
+
+func (as *analyses) def() analysis.A[analysis.Default] {
+	if as.d == nil {
+		a := analysis.Do[analysis.Default](as.t)
+		as.d = &a
+	}
+
+	return *as.d
+}
+
+func (as *analyses) firstless() analysis.A[analysis.Firstless] {
+	if as.f == nil {
+		a := analysis.Do[analysis.Firstless](as.t)
+		as.f = &a
+	}
+
+	return *as.f
+}
+
+type impl struct {
+	name    string
+	strings bool
+	parents bool
+	size    func(as *analyses, parents bool) (uint, error)
+	create  func(t radixt.Tree, parents bool) (radixt.Tree, error)
+}
+
+var impls = [implsAmount]impl{
+	StrgN2: {
+		name:    "strg.N2",
+		strings: true,
+		parents: true,
+		size:    strgSize[strg.N2],
+		create:  strgCreate[strg.N2],
+	},
+	Str3: {
+		name:    "str3",
+		strings: true,
+		parents: true,
+		size: func(as *analyses, parents bool) (uint, error) {
+			return str3.Hoard(as.firstless(), parents)
+		},
+		create: func(t radixt.Tree, parents bool) (radixt.Tree, error) {
+			f := str3.New
+			if parents {
+				f = str3.NewWithParents
+			}
+
+			return f(t)
+		},
+	},
+	StrgN3: {
+		name:    "strg.N3",
+		strings: true,
+		parents: true,
+		size:    strgSize[strg.N3],
+		create:  strgCreate[strg.N3],
+	},
+	Str4: {
+		name:    "str4",
+		strings: true,
+		parents: true,
+		size: func(as *analyses, parents bool) (uint, error) {
+			return str4.Hoard(as.firstless(), parents)
+		},
+		create: func(t radixt.Tree, parents bool) (radixt.Tree, error) {
+			f := str4.New
+			if parents {
+				f = str4.NewWithParents
+			}
+
+			return f(t)
+		},
+	},
+	StrgN4: {
+		name:    "strg.N4",
+		strings: true,
+		parents: true,
+		size:    strgSize[strg.N4],
+		create:  strgCreate[strg.N4],
+	},
+	Struct32: {
+		name: "struct32",
+		size: func(as *analyses, _ bool) (uint, error) {
+			return struct32.Hoard(as.firstless())
+		},
+		create: func(t radixt.Tree, _ bool) (radixt.Tree, error) {
+			return struct32.New(t)
+		},
+	},
+	StructgUint32: {
+		name:    "structg.uint32",
+		parents: true,
+		size:    structgSize[uint32],
+		create:  structgCreate[uint32],
+	},
+	Struct64: {
+		name: "struct64",
+		size: func(as *analyses, _ bool) (uint, error) {
+			return struct64.Hoard(as.firstless())
+		},
+		create: func(t radixt.Tree, _ bool) (radixt.Tree, error) {
+			return struct64.New(t)
+		},
+	},
+	StructgUint64: {
+		name:    "structg.uint64",
+		parents: true,
+		size:    structgSize[uint64],
+		create:  structgCreate[uint64],
+	},
+}
+
+// String returns short name of the implementation, for example "str3" or
+// "strg.N2", or "none" for [None].
+func (i Impl) String() string {
+	switch {
+	case i == None:
+		return "none"
+	case !i.valid():
+		return "unknown"
+	}
+
+	return impls[i].name
+}
+
+func (i Impl) valid() bool {
+	return 0 <= i && i < implsAmount
+}
+
+// strgSize computes length of tree of [compact/strg] implementation with
+// nodes of type NX.
+func strgSize[NX strg.N](as *analyses, parents bool) (uint, error) {
+	return strg.Hoard[NX](as.def(), parents)
+}
+
+func strgCreate[NX strg.N](t radixt.Tree, parents bool) (radixt.Tree, error) {
+	f := strg.New[NX]
+	if parents {
+		f = strg.NewWithParents[NX]
+	}
+
+	return f(t)
+}
+
+// structgSize computes amount of memory, hoarded by tree of
+// [compact/structg] implementation with nodes of type N.
+func structgSize[N node.N](as *analyses, parents bool) (uint, error) {
+	return structg.Hoard[N](as.def(), parents)
+}
+
+func structgCreate[N node.N](t radixt.Tree, parents bool) (radixt.Tree, error) {
+	f := structg.New[N]
+	if parents {
+		f = structg.NewWithParents[N]
+	}
+
+	return f(t)
+}
@@ -0,0 +1,36 @@
+package best_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/compact/best"
+)
+
+var implStringTests = []struct {
+	impl   best.Impl
+	result string
+}{
+	{impl: best.StrgN2, result: "strg.N2"},
+	{impl: best.Str3, result: "str3"},
+	{impl: best.StrgN3, result: "strg.N3"},
+	{impl: best.Str4, result: "str4"},
+	{impl: best.StrgN4, result: "strg.N4"},
+	{impl: best.Struct32, result: "struct32"},
+	{impl: best.StructgUint32, result: "structg.uint32"},
+	{impl: best.Struct64, result: "struct64"},
+	{impl: best.StructgUint64, result: "structg.uint64"},
+	{impl: best.None, result: "none"},
+	{impl: best.Impl(-2), result: "unknown"},
+	{impl: best.Impl(100), result: "unknown"},
+}
+
+const testImplStringError = "Impl String Test %d: got %q (should be %q)"
+
+func TestImplString(t *testing.T) {
+	for i, tt := range implStringTests {
+		result := tt.impl.String()
+		if result != tt.result {
+			t.Errorf(testImplStringError, i, result, tt.result)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package best
+
+import (
+	"errors"
+
+	"github.com/alex-ilchukov/radixt"
+)
+
+// ErrorStrings is reason of rejection of implementation, which is not based
+// on regular Go strings, when [Options.Strings] is set.
+var ErrorStrings = errors.New("implementation is not based on strings")
+
+// ErrorParents is reason of rejection of implementation, which can not store
+// parents of nodes, when [Options.Parents] is set.
+var ErrorParents = errors.New("implementation does not store parents")
+
+// ErrorLarger is reason of rejection of implementation, which fits the tree,
+// but would take more memory than the picked one or the same amount of memory
+// as the picked one, which goes earlier.
+var ErrorLarger = errors.New("implementation would take more memory")
+
+// ErrorNone is returned by [Pick], if no implementation fits the tree.
+var ErrorNone = errors.New("no implementation fits")
+
+// Options represents constraints on the implementations to pick from.
+type Options struct {
+	// Strings restricts the implementations to the ones, based on regular
+	// Go strings, so the trees can be saved as Go constants or loaded
+	// from files.
+	Strings bool
+
+	// Parents restricts the implementations to the ones, which can store
+	// parents of nodes, and makes the picked tree store them, so
+	// [radixt.Parenter] is fast.
+	Parents bool
+}
+
+// Rejection describes implementation Impl, which has not been picked. Reason
+// is one of the Error* values of the package or the error, which the
+// implementation would return, if it can not fit the tree. Size is amount of
+// memory, which the implementation would take, if the reason is
+// [ErrorLarger], or zero otherwise.
+type Rejection struct {
+	Impl   Impl
+	Size   uint
+	Reason error
+}
+
+// Result represents the picked implementation Impl with the created Tree and
+// amount of memory Size, which the tree takes (see [radixt.Hoarder]), and the
+// rest of implementations with reasons of their rejections in order of [Impl]
+// constants. Impl is [None], if no implementation is picked.
+type Result struct {
+	Tree       radixt.Tree
+	Impl       Impl
+	Size       uint
+	Rejections []Rejection
+}
+
+// Pick computes amount of memory, which every implementation, satisfying
+// options o, would take for tree t, and creates the tree with the smallest
+// one. Nil values of t are interpreted as empty tree. It returns result with
+// nil error, if an implementation is picked, or result without tree and with
+// [None] implementation with [ErrorNone] otherwise.
+func Pick(t radixt.Tree, o Options) (Result, error) {
+	as := analyses{t: t}
+	sizes := [implsAmount]uint{}
+	reasons := [implsAmount]error{}
+	picked := implsAmount
+	for i, im := range impls {
+		switch {
+		case o.Strings && !im.strings:
+			reasons[i] = ErrorStrings
+		case o.Parents && !im.parents:
+			reasons[i] = ErrorParents
+		default:
+			sizes[i], reasons[i] = im.size(&as, o.Parents)
+		}
+
+		e := reasons[i] == nil &&
+			(picked == implsAmount || sizes[i] < sizes[picked])
+
+		if e {
+			picked = Impl(i)
+		}
+	}
+
+	r := Result{Impl: None}
+	for i := Impl(0); i < implsAmount; i++ {
+		switch {
+		case i == picked:
+			continue
+		case reasons[i] == nil:
+			r.add(i, sizes[i], ErrorLarger)
+		default:
+			r.add(i, 0, reasons[i])
+		}
+	}
+
+	if picked == implsAmount {
+		return r, ErrorNone
+	}
+
+	tree, err := impls[picked].create(t, o.Parents)
+	if err != nil {
+		return r, err
+	}
+
+	r.Tree = tree
+	r.Impl = picked
+	r.Size = sizes[picked]
+
+	return r, nil
+}
+
+func (r *Result) add(i Impl, size uint, reason error) {
+	rj := Rejection{Impl: i, Size: size, Reason: reason}
+	r.Rejections = append(r.Rejections, rj)
+}
@@ -0,0 +1,360 @@
+package best_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/compact/best"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/compact/struct32"
+	"github.com/alex-ilchukov/radixt/compact/struct64"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/lookup"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var (
+	methods = sapling.New("GET", "PUT", "HEAD")
+
+	headers = sapling.New(
+		"authority",
+		"authorization",
+		"author",
+		"authentication",
+		"auth",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	)
+
+	large = sapling.NewFromSV(
+		sapling.SV{S: "GET", V: 0xFF_FF_FF},
+		sapling.SV{S: "POST", V: 1},
+	)
+
+	huge = sapling.NewFromSV(
+		sapling.SV{S: "GET", V: 0xFF_FF_FF_FF_FF},
+		sapling.SV{S: "POST", V: 1},
+	)
+
+	trees = []radixt.Tree{
+		nil,
+		sapling.New(),
+		sapling.New(""),
+		methods,
+		headers,
+		large,
+		huge,
+	}
+)
+
+// create creates tree of implementation i without package best.
+func create(i best.Impl, t radixt.Tree, parents bool) (radixt.Tree, error) {
+	switch {
+	case i == best.StrgN2 && parents:
+		return strg.NewWithParents[strg.N2](t)
+	case i == best.StrgN2:
+		return strg.New[strg.N2](t)
+	case i == best.Str3 && parents:
+		return str3.NewWithParents(t)
+	case i == best.Str3:
+		return str3.New(t)
+	case i == best.StrgN3 && parents:
+		return strg.NewWithParents[strg.N3](t)
+	case i == best.StrgN3:
+		return strg.New[strg.N3](t)
+	case i == best.Str4 && parents:
+		return str4.NewWithParents(t)
+	case i == best.Str4:
+		return str4.New(t)
+	case i == best.StrgN4 && parents:
+		return strg.NewWithParents[strg.N4](t)
+	case i == best.StrgN4:
+		return strg.New[strg.N4](t)
+	case i == best.Struct32:
+		return struct32.New(t)
+	case i == best.StructgUint32 && parents:
+		return structg.NewWithParents[uint32](t)
+	case i == best.StructgUint32:
+		return structg.New[uint32](t)
+	case i == best.Struct64:
+		return struct64.New(t)
+	case i == best.StructgUint64 && parents:
+		return structg.NewWithParents[uint64](t)
+	default:
+		return structg.New[uint64](t)
+	}
+}
+
+func hoard(t radixt.Tree) uint {
+	amount, _ := t.(radixt.Hoarder).Hoard()
+	return amount
+}
+
+const testPickSizeError = "Pick Size Test %d/%t: %v got %d and %v (should " +
+	"be %d and %v)"
+
+const testPickSizeTreeError = "Pick Size Test %d/%t: got tree\n\n%v\n\n" +
+	"which is not equal to the original one"
+
+// TestPickSize verifies, that the computed sizes and errors of the
+// implementations are the same, as the created trees have.
+func TestPickSize(t *testing.T) {
+	for i, tree := range trees {
+		for _, parents := range []bool{false, true} {
+			o := best.Options{Parents: parents}
+			r, err := best.Pick(tree, o)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !evident.New(tree).Eq(r.Tree) {
+				t.Errorf(
+					testPickSizeTreeError,
+					i,
+					parents,
+					evident.New(r.Tree),
+				)
+			}
+
+			sizes := map[best.Impl]uint{r.Impl: r.Size}
+			reasons := map[best.Impl]error{}
+			for _, rj := range r.Rejections {
+				sizes[rj.Impl] = rj.Size
+				if rj.Reason != best.ErrorLarger {
+					reasons[rj.Impl] = rj.Reason
+				}
+			}
+
+			for im := best.StrgN2; im <= best.StructgUint64; im++ {
+				e := im == best.Struct32 || im == best.Struct64
+				if parents && e {
+					continue
+				}
+
+				c, err := create(im, tree, parents)
+				size := uint(0)
+				if err == nil {
+					size = hoard(c)
+				}
+
+				if sizes[im] != size || reasons[im] != err {
+					t.Errorf(
+						testPickSizeError,
+						i,
+						parents,
+						im,
+						sizes[im],
+						reasons[im],
+						size,
+						err,
+					)
+				}
+			}
+		}
+	}
+}
+
+var pickTests = []struct {
+	tree    radixt.Tree
+	o       best.Options
+	result1 best.Impl
+	result2 uint
+	result3 error
+}{
+	{
+		tree:    nil,
+		o:       best.Options{},
+		result1: best.StrgN2,
+		result2: strg.ProperLen,
+		result3: nil,
+	},
+	{
+		tree:    methods,
+		o:       best.Options{},
+		result1: best.StrgN2,
+		result2: 28,
+		result3: nil,
+	},
+	{
+		tree:    methods,
+		o:       best.Options{Parents: true},
+		result1: best.StrgN2,
+		result2: 28,
+		result3: nil,
+	},
+	{
+		tree:    headers,
+		o:       best.Options{},
+		result1: best.Str3,
+		result2: 92,
+		result3: nil,
+	},
+	{
+		tree:    large,
+		o:       best.Options{},
+		result1: best.Str4,
+		result2: 30,
+		result3: nil,
+	},
+	{
+		tree:    huge,
+		o:       best.Options{},
+		result1: best.StructgUint64,
+		result2: 79,
+		result3: nil,
+	},
+	{
+		tree:    huge,
+		o:       best.Options{Strings: true},
+		result1: best.None,
+		result2: 0,
+		result3: best.ErrorNone,
+	},
+}
+
+const testPickError = "Pick Test %d: got %v, %d, and %v (should be %v, %d, " +
+	"and %v)"
+
+const testPickTreeError = "Pick Test %d: got tree %v with error"
+
+const testPickSwitcherError = "Pick Test %d: got tree %v, which is not " +
+	"switcher"
+
+func TestPick(t *testing.T) {
+	for i, tt := range pickTests {
+		r, result3 := best.Pick(tt.tree, tt.o)
+		result1, result2 := r.Impl, r.Size
+		e := result1 != tt.result1 ||
+			result2 != tt.result2 ||
+			result3 != tt.result3
+
+		if e {
+			t.Errorf(
+				testPickError,
+				i,
+				result1,
+				result2,
+				result3,
+				tt.result1,
+				tt.result2,
+				tt.result3,
+			)
+		}
+
+		if result3 != nil {
+			if r.Tree != nil {
+				t.Errorf(testPickTreeError, i, r.Tree)
+			}
+
+			continue
+		}
+
+		if _, ok := r.Tree.(lookup.Switcher); !ok {
+			t.Errorf(testPickSwitcherError, i, r.Tree)
+		}
+	}
+}
+
+var pickRejectionsTests = []struct {
+	tree    radixt.Tree
+	o       best.Options
+	reasons []error
+}{
+	{
+		tree: headers,
+		o:    best.Options{},
+		reasons: []error{
+			compact.ErrorOverflow,
+			best.ErrorLarger,
+			best.ErrorLarger,
+			best.ErrorLarger,
+			best.ErrorLarger,
+			best.ErrorLarger,
+			best.ErrorLarger,
+			best.ErrorLarger,
+		},
+	},
+	{
+		tree: methods,
+		o:    best.Options{Strings: true, Parents: true},
+		reasons: []error{
+			best.ErrorLarger,
+			best.ErrorLarger,
+			best.ErrorLarger,
+			best.ErrorLarger,
+			best.ErrorStrings,
+			best.ErrorStrings,
+			best.ErrorStrings,
+			best.ErrorStrings,
+		},
+	},
+	{
+		tree: large,
+		o:    best.Options{Parents: true},
+		reasons: []error{
+			compact.ErrorOverflow,
+			compact.ErrorOverflow,
+			compact.ErrorOverflow,
+			compact.ErrorOverflow,
+			compact.ErrorOverflow,
+			best.ErrorParents,
+			compact.ErrorOverflow,
+			best.ErrorParents,
+		},
+	},
+	{
+		tree: huge,
+		o:    best.Options{Strings: true},
+		reasons: []error{
+			compact.ErrorOverflow,
+			compact.ErrorOverflow,
+			compact.ErrorOverflow,
+			compact.ErrorOverflow,
+			compact.ErrorOverflow,
+			best.ErrorStrings,
+			best.ErrorStrings,
+			best.ErrorStrings,
+			best.ErrorStrings,
+		},
+	},
+}
+
+const testPickRejectionsError = "Pick Rejections Test %d: got %v for %v " +
+	"(should be %v)"
+
+const testPickRejectionsLenError = "Pick Rejections Test %d: got %d " +
+	"rejections (should be %d)"
+
+func TestPickRejections(t *testing.T) {
+	for i, tt := range pickRejectionsTests {
+		r, _ := best.Pick(tt.tree, tt.o)
+		if len(r.Rejections) != len(tt.reasons) {
+			t.Errorf(
+				testPickRejectionsLenError,
+				i,
+				len(r.Rejections),
+				len(tt.reasons),
+			)
+
+			continue
+		}
+
+		for j, rj := range r.Rejections {
+			if !errors.Is(rj.Reason, tt.reasons[j]) {
+				t.Errorf(
+					testPickRejectionsError,
+					i,
+					rj.Reason,
+					rj.Impl,
+					tt.reasons[j],
+				)
+			}
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package compact
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrorInvalidLenNode is used by common machinery of the compact
 // implementations of radix trees to indicate, that there is disrepancy between
@@ -23,3 +26,30 @@ var ErrorChunksOverflow = errors.New("chunks would not fit")
 // implementations to indicate, that the nodes of the provided tree would not
 // fit into the implementation.
 var ErrorNodesOverflow = errors.New("nodes would not fit")
+
+// ErrorInvalid is wrapped by every [InvalidError], so errors.Is can be used to
+// check, if a string is rejected as invalid tree.
+var ErrorInvalid = errors.New("invalid tree")
+
+// InvalidError is used by validation of string-based compact implementations
+// of radix trees to indicate, what is wrong in the string and where. Node is
+// index of the node, where the problem is found, or -1, if the problem is in
+// the header of the tree.
+type InvalidError struct {
+	Node   int
+	Reason string
+}
+
+// Error returns description of the error.
+func (e *InvalidError) Error() string {
+	if e.Node < 0 {
+		return fmt.Sprintf("%v: %s", ErrorInvalid, e.Reason)
+	}
+
+	return fmt.Sprintf("%v: node %d: %s", ErrorInvalid, e.Node, e.Reason)
+}
+
+// Unwrap returns [ErrorInvalid].
+func (e *InvalidError) Unwrap() error {
+	return ErrorInvalid
+}
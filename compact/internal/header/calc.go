@@ -12,9 +12,19 @@ import (
 // analysis and return node in form of type from [internal/node.N] type set.
 type NodeFactory[N node.N, M analysis.Mode] func(n analysis.N[M]) N
 
-type fields [fieldsAmount]uint
-type fieldLens [fieldsAmount]int
-type fieldShifts [fieldsAmount]byte
+type fields [fieldsAll]uint
+type fieldLens [fieldsAll]int
+type fieldShifts [fieldsAll]byte
+
+// order is order of fields in node bit string, starting from the lowest bits.
+var order = [fieldsAll]int{
+	fieldChunkPos,
+	fieldValue,
+	fieldChildrenStart,
+	fieldChildrenAmount,
+	fieldParent,
+	fieldChunkLen,
+}
 
 // Calc takes maximum used bits length of node, which can be less or equal to
 // actual bits length of node, with result of tree analysis. It returns proper
@@ -28,13 +38,41 @@ func Calc[N node.N, M analysis.Mode](lenNode int, a analysis.A[M]) (
 	h A8b,
 	nf NodeFactory[N, M],
 	err error,
+) {
+	return calc[N](lenNode, a, false)
+}
+
+// CalcWithParents is like [Calc], but the nodes, created by nf, also contain
+// field of parent, which is sized like the other fields. The field is placed
+// just before the tail, so h is the same as [Calc] would return for the nodes
+// without the field, except the tail shift, and it tells, if the nodes have
+// the field (see [Parent]).
+func CalcWithParents[N node.N, M analysis.Mode](
+	lenNode int,
+	a analysis.A[M],
+) (
+	h A8b,
+	nf NodeFactory[N, M],
+	err error,
+) {
+	return calc[N](lenNode, a, true)
+}
+
+func calc[N node.N, M analysis.Mode](
+	lenNode int,
+	a analysis.A[M],
+	parents bool,
+) (
+	h A8b,
+	nf NodeFactory[N, M],
+	err error,
 ) {
 	if node.BitsLen[N]() < lenNode {
 		err = compact.ErrorInvalidLenNode
 		return
 	}
 
-	lens := fillLens(a)
+	lens := fillLens(a, parents)
 	l := lenNode
 	for _, fieldLen := range lens {
 		l -= fieldLen
@@ -51,7 +89,9 @@ func Calc[N node.N, M analysis.Mode](lenNode int, a analysis.A[M]) (
 	return
 }
 
-func fillLens[M analysis.Mode](a analysis.A[M]) (lens fieldLens) {
+func fillLens[M analysis.Mode](a analysis.A[M], parents bool) (
+	lens fieldLens,
+) {
 	lens[fieldChunkPos] = bits.Len(uint(len(a.C)))
 	// Zero is NoValue, so (a.Vm + 1) values would be in use
 	lens[fieldValue] = bits.Len(a.Vm + 1)
@@ -67,6 +107,19 @@ func fillLens[M analysis.Mode](a analysis.A[M]) (lens fieldLens) {
 	lens[fieldChildrenAmount] = bits.Len(a.Cma)
 	lens[fieldChunkLen] = bits.Len(a.Cml)
 
+	// Parent is kept as difference between indices of node and its parent,
+	// which is positive for any node but the root, where it is zero.
+	if parents {
+		dpm := uint(0)
+		for _, n := range a.N {
+			if n.Index > 0 && dpm < n.Index-n.Parent {
+				dpm = n.Index - n.Parent
+			}
+		}
+
+		lens[fieldParent] = bits.Len(dpm)
+	}
+
 	return
 }
 
@@ -78,6 +131,7 @@ func fillHeader(lenNode int, lens fieldLens) (h A8b) {
 		h[2*i-1] = ls                    // left shift
 		h[2*i] = byte(lenNode - lens[i]) // right shift
 	}
+	ls -= byte(lens[fieldParent])
 	h[Len-1] = byte(lenNode) - ls
 
 	return
@@ -102,20 +156,24 @@ func createNodeFactory[N node.N, M analysis.Mode](lens fieldLens) (
 			f[fieldChildrenStart] = n.ChildrenLow - n.Index - 1
 		}
 
+		if lens[fieldParent] > 0 && n.Index > 0 {
+			f[fieldParent] = n.Index - n.Parent
+		}
+
 		return N(placeFields(f, s))
 	}
 }
 
 func fillShifts(lens fieldLens) (s fieldShifts) {
-	for i := 1; i < fieldsAmount; i++ {
-		s[i] = s[i-1] + byte(lens[i-1])
+	for i := 1; i < fieldsAll; i++ {
+		s[order[i]] = s[order[i-1]] + byte(lens[order[i-1]])
 	}
 
 	return
 }
 
 func placeFields(f fields, s fieldShifts) (result uint) {
-	for i := 0; i < fieldsAmount; i++ {
+	for i := 0; i < fieldsAll; i++ {
 		result |= f[i] << s[i]
 	}
 
@@ -9,11 +9,16 @@
 //   - chunk's length;
 //   - value;
 //   - index of first child;
-//   - amount of children.
+//   - amount of children;
+//   - optionally, index of parent.
 //
 // Some data is packed as is, other one is mogrified. So, the bit string
 // divided into five continious regions accordingly to names of functions in
 // [internal/node]: head, body 1, body 2, body 3, tail. As the head and the
 // tail would need a byte parameter each to extract, and the bodies would need
 // two bytes each, the total is eight bytes. That's the length of a header.
+//
+// The optional field of parent takes the gap between body 3 and the tail, so
+// it does not need bytes of its own: the header tells, if the nodes have the
+// field, by shift of the tail.
 package header
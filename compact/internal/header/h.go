@@ -9,6 +9,7 @@ import "github.com/alex-ilchukov/radixt/compact/internal/node"
 //   - body 1 — value (mogrified);
 //   - body 2 — index of first child (mogrified);
 //   - body 3 — amount of children;
+//   - optional gap — index of parent (mogrified);
 //   - tail — chunk's length.
 const (
 	fieldChunkPos = iota
@@ -19,6 +20,14 @@ const (
 	fieldsAmount
 )
 
+// fieldParent is index of the optional field of parent, which does not need
+// its own bytes in header: length of the field is the gap between body 3 and
+// the tail.
+const (
+	fieldParent = fieldsAmount + iota
+	fieldsAll
+)
+
 // Len is amount of bytes, required to form the header
 const Len = 2 + (fieldsAmount-2)*2
 
@@ -89,3 +98,41 @@ func ChildrenRange[N node.N, Header H](i uint, n N, h Header) (lo, hi uint) {
 
 	return
 }
+
+// parentShifts returns left and right shifts to extract field of parent from
+// node of type N with header h, and boolean true flag, if the node has the
+// field, or default values otherwise.
+func parentShifts[N node.N, Header H](h Header) (ls, rs byte, has bool) {
+	bits := node.BitsLen[N]()
+	ls = h[2*fieldChildrenAmount-1]
+	l := int(h[Len-1]) + int(ls) - bits
+	if l <= 0 || l > int(ls) {
+		return 0, 0, false
+	}
+
+	return ls - byte(l), byte(bits - l), true
+}
+
+// HasParents takes header h and returns if nodes of type N with the header
+// have field of parent.
+func HasParents[N node.N, Header H](h Header) bool {
+	_, _, has := parentShifts[N](h)
+	return has
+}
+
+// Parent takes node n with its index i and header h, and returns parent p of
+// the node with boolean true flag, if the node has field of parent (see
+// [CalcWithParents]) and is not root, or default values otherwise.
+func Parent[N node.N, Header H](i uint, n N, h Header) (p uint, ok bool) {
+	ls, rs, has := parentShifts[N](h)
+	if !has {
+		return
+	}
+
+	d := node.Body(n, ls, rs)
+	if d > 0 && d <= i {
+		p, ok = i-d, true
+	}
+
+	return
+}
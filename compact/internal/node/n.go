@@ -1,5 +1,7 @@
 package node
 
+import "math/bits"
+
 // N is union type set for types of nodes of compact radix tree
 // implementations.
 type N interface {
@@ -9,11 +11,9 @@ type N interface {
 // BitsLen returns 32 for types with underlying type uint32 and 64 for types
 // with underlying type uint64.
 func BitsLen[T N]() int {
-	if ^T(0)>>32 > 0 {
-		return 64
-	}
-
-	return 32
+	// Checking ^T(0)>>32 instead is reported by go vet as too large shift
+	// for 32-bit types.
+	return bits.Len64(uint64(^T(0)))
 }
 
 // Head returns the lowest bits of n in amount of (BitsLen[T]() - s) in form of
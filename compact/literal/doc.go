@@ -0,0 +1,12 @@
+// Package literal provides generation of Go source code, which declares
+// compactified radix trees, based on regular Go strings, as Go constants. As
+// the trees are constants, they cost zero startup work and are placed in
+// read-only data of binaries.
+//
+// The package builds [sapling.Tree] from the provided keys with values, and
+// then tries to compactify it with the chosen implementation. If the
+// implementation reports an overflow error, the package falls back to the
+// next implementation in order of [Impl] constants. Besides the tree
+// constant, the generated code declares a named constant for every key with
+// the key's value.
+package literal
@@ -0,0 +1,209 @@
+package literal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+// ErrorKeyName is returned by [Generate], if names of constants for two
+// different keys coincide.
+var ErrorKeyName = errors.New("keys have the same constant name")
+
+// ErrorKeyNameEmpty is returned by [Generate], if a non-empty key has no ASCII
+// letters or digits to build name of its constant from.
+var ErrorKeyNameEmpty = errors.New("key has no letters or digits for name")
+
+const generatedBy = "// Code generated by " +
+	"github.com/alex-ilchukov/radixt/compact/literal. DO NOT EDIT.\n\n"
+
+// bytesPerLine is amount of tree bytes per line of the generated literal.
+const bytesPerLine = 16
+
+// Generate builds [sapling.Tree] from keys with values of sv, compactifies it,
+// and writes to w Go source code, which declares the compactified tree as
+// constant with name o.Name, and constants for the keys with values, in
+// package o.Package. It starts with o.Impl implementation and falls back to
+// the next ones, if [compact.ErrorOverflow], [compact.ErrorNodesOverflow], or
+// [compact.ErrorChunksOverflow] is returned. It returns the implementation
+// picked with nil error in case of success. Otherwise it returns the last
+// tried implementation with the following errors:
+//
+//  1. [ErrorPackage], [ErrorName], or [ErrorImpl], if the options are
+//     invalid;
+//  2. [ErrorKeyName], if two keys have the same constant name, or
+//     [ErrorKeyNameEmpty], if a key has nothing to build the name from;
+//  3. the overflow error of the last implementation, if no implementation
+//     fits the tree;
+//  4. error of writing to w.
+func Generate(w io.Writer, sv []sapling.SV, o Options) (Impl, error) {
+	if err := o.check(); err != nil {
+		return o.Impl, err
+	}
+
+	names, err := keyNames(o.Name, sv)
+	if err != nil {
+		return o.Impl, err
+	}
+
+	t := sapling.NewFromSV(sv...)
+	i := o.Impl
+	for {
+		s, err := impls[i].create(t)
+		switch {
+		case err == nil:
+			return i, write(w, o, i, s, names)
+
+		case !overflow(err) || i+1 == implsAmount:
+			return i, err
+		}
+
+		i++
+	}
+}
+
+func overflow(err error) bool {
+	return err == compact.ErrorOverflow ||
+		err == compact.ErrorNodesOverflow ||
+		err == compact.ErrorChunksOverflow
+}
+
+type keyName struct {
+	key  string
+	name string
+	v    uint
+}
+
+func keyNames(prefix string, sv []sapling.SV) ([]keyName, error) {
+	byKey := make(map[string]int, len(sv))
+	byName := make(map[string]string, len(sv))
+	names := make([]keyName, 0, len(sv))
+	for _, e := range sv {
+		if i, has := byKey[e.S]; has {
+			names[i].v = e.V
+			continue
+		}
+
+		name := prefix + camel(e.S)
+		if name == prefix {
+			return nil, fmt.Errorf("%w: %q", ErrorKeyNameEmpty, e.S)
+		}
+
+		if key, has := byName[name]; has {
+			return nil, fmt.Errorf("%w: %q, %q", ErrorKeyName, key, e.S)
+		}
+
+		byKey[e.S] = len(names)
+		byName[name] = e.S
+		names = append(names, keyName{key: e.S, name: name, v: e.V})
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return names[i].key < names[j].key
+	})
+
+	return names, nil
+}
+
+func camel(key string) string {
+	if key == "" {
+		return "Empty"
+	}
+
+	b := make([]byte, 0, len(key))
+	upper := true
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case 'a' <= c && c <= 'z':
+			if upper {
+				c -= 'a' - 'A'
+			}
+		case 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+			// no statement
+		default:
+			upper = true
+			continue
+		}
+
+		b = append(b, c)
+		upper = false
+	}
+
+	return string(b)
+}
+
+func write(w io.Writer, o Options, i Impl, s string, names []keyName) error {
+	var b bytes.Buffer
+	p := func(format string, args ...any) {
+		fmt.Fprintf(&b, format, args...)
+	}
+
+	p(generatedBy)
+	p("package %s\n\n", o.Package)
+	p("import %q\n\n", impls[i].path)
+
+	p("// %s is compactified radix tree with %s implementation.\n", o.Name, i)
+	p("const %s %s = ", o.Name, i)
+	if s == "" {
+		p("\"\"")
+	}
+	for l := 0; l < len(s); l += bytesPerLine {
+		if l > 0 {
+			p(" +\n")
+		}
+
+		h := l + bytesPerLine
+		if h > len(s) {
+			h = len(s)
+		}
+		p("%s", quote(s[l:h]))
+	}
+	p("\n")
+
+	if len(names) > 0 {
+		p("\n// Values of keys in %s tree.\n", o.Name)
+		p("const (\n")
+		for _, n := range names {
+			p("%s = %d // %s\n", n.name, n.v, strconv.Quote(n.key))
+		}
+		p(")\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+// quote returns double-quoted Go string literal for s, where every byte,
+// which is not printable ASCII character, is presented by \x escape sequence.
+func quote(s string) string {
+	const hex = "0123456789abcdef"
+
+	b := make([]byte, 0, 2+4*len(s))
+	b = append(b, '"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			b = append(b, '\\', c)
+		case c < 0x20 || c >= 0x7F:
+			b = append(b, '\\', 'x', hex[c>>4], hex[c&0xF])
+		default:
+			b = append(b, c)
+		}
+	}
+
+	return string(append(b, '"'))
+}
@@ -0,0 +1,247 @@
+package literal_test
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/compact/literal"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var (
+	methods = []sapling.SV{
+		{S: "GET", V: 0},
+		{S: "POST", V: 1},
+		{S: "PATCH", V: 2},
+		{S: "DELETE", V: 3},
+		{S: "PUT", V: 4},
+	}
+
+	headers = []sapling.SV{
+		{S: "authorization", V: 0},
+		{S: "content-type", V: 1},
+		{S: "content-length", V: 2},
+		{S: "content-disposition", V: 3},
+		{S: "content-length", V: 4},
+		{S: "", V: 5},
+	}
+
+	large = []sapling.SV{
+		{S: "GET", V: 0xFF_FF_FF},
+		{S: "POST", V: 1},
+	}
+
+	huge = []sapling.SV{
+		{S: "GET", V: 0xFF_FF_FF_FF_FF},
+		{S: "POST", V: 1},
+	}
+
+	collision = []sapling.SV{
+		{S: "content-type", V: 0},
+		{S: "content_type", V: 1},
+	}
+
+	nameless = []sapling.SV{
+		{S: "GET", V: 0},
+		{S: "\xd0\xb8\xd0\xbc\xd1\x8f", V: 1},
+	}
+)
+
+var generateTests = []struct {
+	sv      []sapling.SV
+	o       literal.Options
+	golden  string
+	result1 literal.Impl
+	result2 error
+}{
+	{
+		sv:      nil,
+		o:       literal.Options{Package: "empty", Name: "Empty"},
+		golden:  "empty.golden",
+		result1: literal.StrgN2,
+		result2: nil,
+	},
+	{
+		sv: methods,
+		o: literal.Options{
+			Package: "methods",
+			Name:    "Methods",
+			Impl:    literal.StrgN3,
+		},
+		golden:  "methods.golden",
+		result1: literal.StrgN3,
+		result2: nil,
+	},
+	{
+		sv: headers,
+		o: literal.Options{
+			Package: "headers",
+			Name:    "Headers",
+			Impl:    literal.Str3,
+		},
+		golden:  "headers.golden",
+		result1: literal.Str3,
+		result2: nil,
+	},
+	{
+		sv:      headers,
+		o:       literal.Options{Package: "headers", Name: "Headers"},
+		golden:  "headers2.golden",
+		result1: literal.StrgN2,
+		result2: nil,
+	},
+	{
+		sv:      large,
+		o:       literal.Options{Package: "large", Name: "Large"},
+		golden:  "large.golden",
+		result1: literal.Str4,
+		result2: nil,
+	},
+	{
+		sv:      huge,
+		o:       literal.Options{Package: "huge", Name: "Huge"},
+		result1: literal.StrgN4,
+		result2: compact.ErrorOverflow,
+	},
+	{
+		sv:      collision,
+		o:       literal.Options{Package: "headers", Name: "Headers"},
+		result1: literal.StrgN2,
+		result2: literal.ErrorKeyName,
+	},
+	{
+		sv:      nameless,
+		o:       literal.Options{Package: "nameless", Name: "Nameless"},
+		result1: literal.StrgN2,
+		result2: literal.ErrorKeyNameEmpty,
+	},
+	{
+		sv:      methods,
+		o:       literal.Options{Name: "Methods"},
+		result1: literal.StrgN2,
+		result2: literal.ErrorPackage,
+	},
+	{
+		sv:      methods,
+		o:       literal.Options{Package: "methods", Name: "methods"},
+		result1: literal.StrgN2,
+		result2: literal.ErrorName,
+	},
+	{
+		sv: methods,
+		o: literal.Options{
+			Package: "methods",
+			Name:    "Methods",
+			Impl:    literal.Impl(100),
+		},
+		result1: literal.Impl(100),
+		result2: literal.ErrorImpl,
+	},
+}
+
+const testGenerateError = "Generate Test %d: got %v and %v (should be %v " +
+	"and %v)"
+
+const testGenerateGoldenError = "Generate Test %d: got\n\n%s\n\nwhich is " +
+	"not equal to content of %s file"
+
+func TestGenerate(t *testing.T) {
+	for i, tt := range generateTests {
+		var b bytes.Buffer
+		result1, result2 := literal.Generate(&b, tt.sv, tt.o)
+		if result1 != tt.result1 || !errors.Is(result2, tt.result2) {
+			t.Errorf(
+				testGenerateError,
+				i,
+				result1,
+				result2,
+				tt.result1,
+				tt.result2,
+			)
+		}
+
+		if tt.golden == "" {
+			continue
+		}
+
+		path := filepath.Join("testdata", tt.golden)
+		if *update {
+			if err := os.WriteFile(path, b.Bytes(), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		golden, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(b.Bytes(), golden) {
+			t.Errorf(testGenerateGoldenError, i, b.String(), path)
+		}
+	}
+}
+
+var parseImplTests = []struct {
+	name    string
+	result1 literal.Impl
+	result2 error
+}{
+	{name: "strg2", result1: literal.StrgN2, result2: nil},
+	{name: "str3", result1: literal.Str3, result2: nil},
+	{name: "strg3", result1: literal.StrgN3, result2: nil},
+	{name: "str4", result1: literal.Str4, result2: nil},
+	{name: "strg4", result1: literal.StrgN4, result2: nil},
+	{name: "struct32", result1: literal.StrgN2, result2: literal.ErrorImpl},
+	{name: "", result1: literal.StrgN2, result2: literal.ErrorImpl},
+}
+
+const testParseImplError = "ParseImpl Test %d: got %v and %v for %q (should " +
+	"be %v and %v)"
+
+func TestParseImpl(t *testing.T) {
+	for i, tt := range parseImplTests {
+		result1, result2 := literal.ParseImpl(tt.name)
+		if result1 != tt.result1 || result2 != tt.result2 {
+			t.Errorf(
+				testParseImplError,
+				i,
+				result1,
+				result2,
+				tt.name,
+				tt.result1,
+				tt.result2,
+			)
+		}
+	}
+}
+
+var implStringTests = []struct {
+	impl   literal.Impl
+	result string
+}{
+	{impl: literal.StrgN2, result: "strg.Tree[strg.N2]"},
+	{impl: literal.Str3, result: "str3.Tree"},
+	{impl: literal.StrgN3, result: "strg.Tree[strg.N3]"},
+	{impl: literal.Str4, result: "str4.Tree"},
+	{impl: literal.StrgN4, result: "strg.Tree[strg.N4]"},
+	{impl: literal.Impl(-1), result: "unknown"},
+}
+
+const testImplStringError = "Impl String Test %d: got %q (should be %q)"
+
+func TestImplString(t *testing.T) {
+	for i, tt := range implStringTests {
+		result := tt.impl.String()
+		if result != tt.result {
+			t.Errorf(testImplStringError, i, result, tt.result)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package literal
+
+import (
+	"errors"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+)
+
+// Impl represents a compactified implementation of radix tree, based on
+// regular Go strings.
+type Impl int
+
+// The implementations are ordered by size of their nodes. If an
+// implementation fails to fit a tree, the next one is tried.
+const (
+	StrgN2 Impl = iota
+	Str3
+	StrgN3
+	Str4
+	StrgN4
+	implsAmount
+)
+
+// ErrorImpl is returned, if unknown implementation is provided or requested.
+var ErrorImpl = errors.New("unknown implementation")
+
+type impl struct {
+	name   string
+	ty     string
+	path   string
+	create func(radixt.Tree) (string, error)
+}
+
+var impls = [implsAmount]impl{
+	StrgN2: {
+		name: "strg2",
+		ty:   "strg.Tree[strg.N2]",
+		path: "github.com/alex-ilchukov/radixt/compact/strg",
+		create: func(t radixt.Tree) (string, error) {
+			result, err := strg.New[strg.N2](t)
+			return string(result), err
+		},
+	},
+	Str3: {
+		name: "str3",
+		ty:   "str3.Tree",
+		path: "github.com/alex-ilchukov/radixt/compact/str3",
+		create: func(t radixt.Tree) (string, error) {
+			result, err := str3.New(t)
+			return string(result), err
+		},
+	},
+	StrgN3: {
+		name: "strg3",
+		ty:   "strg.Tree[strg.N3]",
+		path: "github.com/alex-ilchukov/radixt/compact/strg",
+		create: func(t radixt.Tree) (string, error) {
+			result, err := strg.New[strg.N3](t)
+			return string(result), err
+		},
+	},
+	Str4: {
+		name: "str4",
+		ty:   "str4.Tree",
+		path: "github.com/alex-ilchukov/radixt/compact/str4",
+		create: func(t radixt.Tree) (string, error) {
+			result, err := str4.New(t)
+			return string(result), err
+		},
+	},
+	StrgN4: {
+		name: "strg4",
+		ty:   "strg.Tree[strg.N4]",
+		path: "github.com/alex-ilchukov/radixt/compact/strg",
+		create: func(t radixt.Tree) (string, error) {
+			result, err := strg.New[strg.N4](t)
+			return string(result), err
+		},
+	},
+}
+
+// ParseImpl returns implementation by its short name (strg2, str3, strg3, str4,
+// or strg4) with nil error, or [ErrorImpl] if the name is unknown.
+func ParseImpl(name string) (Impl, error) {
+	for i, im := range impls {
+		if im.name == name {
+			return Impl(i), nil
+		}
+	}
+
+	return 0, ErrorImpl
+}
+
+// String returns Go type of the implementation, for example "str3.Tree" or
+// "strg.Tree[strg.N3]".
+func (i Impl) String() string {
+	if !i.valid() {
+		return "unknown"
+	}
+
+	return impls[i].ty
+}
+
+func (i Impl) valid() bool {
+	return 0 <= i && i < implsAmount
+}
@@ -0,0 +1,42 @@
+package literal
+
+import (
+	"errors"
+	"go/token"
+)
+
+// ErrorPackage is returned by [Generate], if the provided name of package is
+// not a valid Go identifier.
+var ErrorPackage = errors.New("package name is not a valid identifier")
+
+// ErrorName is returned by [Generate], if the provided name of tree constant
+// is not a valid exported Go identifier.
+var ErrorName = errors.New("name is not a valid exported identifier")
+
+// Options represents settings of the generated code.
+type Options struct {
+	// Package is name of package of the generated code.
+	Package string
+
+	// Name is name of the generated tree constant. It is also used as
+	// prefix of names of the key constants.
+	Name string
+
+	// Impl is the implementation to try first.
+	Impl Impl
+}
+
+func (o Options) check() error {
+	switch {
+	case !token.IsIdentifier(o.Package):
+		return ErrorPackage
+
+	case !token.IsIdentifier(o.Name) || !token.IsExported(o.Name):
+		return ErrorName
+
+	case !o.Impl.valid():
+		return ErrorImpl
+	}
+
+	return nil
+}
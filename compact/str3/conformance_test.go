@@ -0,0 +1,12 @@
+package str3_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+func TestConformance(t *testing.T) {
+	treetest.Conformance(t, treetest.Builder(str3.New))
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/analysis"
 	"github.com/alex-ilchukov/radixt/compact"
 	"github.com/alex-ilchukov/radixt/compact/str3"
 	"github.com/alex-ilchukov/radixt/evident"
@@ -118,3 +119,33 @@ func TestNew(t *testing.T) {
 		}
 	}
 }
+
+const testHoardError = "Test Hoard %d/%t: got %d and \"%v\" error (should " +
+	"be %d and \"%v\")"
+
+func TestHoard(t *testing.T) {
+	for i, tt := range newErrorTests {
+		a := analysis.Do[analysis.Firstless](tt.tree)
+		for _, parents := range []bool{false, true} {
+			f := str3.New
+			if parents {
+				f = str3.NewWithParents
+			}
+
+			tree, err := f(tt.tree)
+			size, _ := tree.Hoard()
+			result1, result2 := str3.Hoard(a, parents)
+			if result1 != size || result2 != err {
+				t.Errorf(
+					testHoardError,
+					i,
+					parents,
+					result1,
+					result2,
+					size,
+					err,
+				)
+			}
+		}
+	}
+}
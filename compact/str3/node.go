@@ -3,8 +3,9 @@ package str3
 type node uint32
 
 const (
-	maskShift = 0x1F
+	maskShift = 0x3F
 	nodeLen = 3
+	nodeBits = 32
 )
 
 func head(n node, s byte) uint {
@@ -0,0 +1,125 @@
+package str3_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/null"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+func mustCreateWithParents(t radixt.Tree) str3.Tree {
+	result, err := str3.NewWithParents(t)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+var ptree = mustCreateWithParents(
+	sapling.New(
+		"authority",
+		"authorization",
+		"author",
+		"authentication",
+		"auth",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	),
+)
+
+var newWithParentsErrorTests = []struct {
+	tree    radixt.Tree
+	result2 error
+}{
+	{tree: nil, result2: nil},
+	{tree: emptyOriginal, result2: nil},
+	{tree: null.Tree, result2: nil},
+	{tree: regularValues, result2: nil},
+	{tree: borderValues, result2: compact.ErrorOverflow},
+	{tree: largeValues, result2: compact.ErrorOverflow},
+}
+
+const testNewWithParentsErrorError = "Test New With Parents Error %d: got " +
+	"\"%s\" error (should be \"%s\")"
+
+func TestNewWithParentsError(t *testing.T) {
+	for i, tt := range newWithParentsErrorTests {
+		_, result2 := str3.NewWithParents(tt.tree)
+		if result2 != tt.result2 {
+			t.Errorf(
+				testNewWithParentsErrorError,
+				i,
+				result2,
+				tt.result2,
+			)
+		}
+	}
+}
+
+const testNewWithParentsError = "Test New With Parents %d: got that " +
+	"NewWithParents(%v) is\n\n%v\n\nwhich is not equal to\n\n%v\n\n" +
+	"(but should be equal)"
+
+func TestNewWithParents(t *testing.T) {
+	for i, tt := range newTests {
+		result, _ := str3.NewWithParents(tt.tree)
+		if !tt.e.Eq(result) {
+			t.Errorf(
+				testNewWithParentsError,
+				i,
+				tt.tree,
+				result,
+				tt.e,
+			)
+		}
+	}
+}
+
+var treeParentTests = []str3.Tree{
+	blank,
+	tooshort,
+	empty,
+	atree,
+	ptree,
+	mustCreateWithParents(sapling.New("")),
+	mustCreateWithParents(sapling.New("", "a", "ab")),
+	mustCreateWithParents(regularValues),
+}
+
+const testTreeParentError = "Tree Parent Test %d: got %d and %t for parent " +
+	"of node %d (should be %d and %t)"
+
+func TestTreeParent(t *testing.T) {
+	for i, tree := range treeParentTests {
+		size := tree.Size()
+		parents := make([]uint, size+1)
+		has := make([]bool, size+1)
+		for p := uint(0); p < size; p++ {
+			tree.EachChild(p, func(c uint) bool {
+				parents[c] = p
+				has[c] = true
+				return false
+			})
+		}
+
+		for n := uint(0); n <= size; n++ {
+			p, ok := tree.Parent(n)
+			if p != parents[n] || ok != has[n] {
+				t.Errorf(
+					testTreeParentError,
+					i,
+					p,
+					ok,
+					n,
+					parents[n],
+					has[n],
+				)
+			}
+		}
+	}
+}
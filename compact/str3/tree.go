@@ -99,6 +99,36 @@ func (t Tree) EachChild(n uint, e func(uint) bool) {
 	}
 }
 
+// Parent returns parent p of node n with boolean true flag, if the tree has
+// the node and the node is not root, or default unsigned integer with boolean
+// false otherwise. It takes constant time, if the tree is created by
+// [NewWithParents], or time, proportional to difference between indices of the
+// node and its parent, otherwise.
+func (t Tree) Parent(n uint) (p uint, ok bool) {
+	size := t.Size()
+	if n == 0 || n >= size {
+		return
+	}
+
+	if ls, rs, has := t.parentShifts(); has {
+		d := body(t.node(n, size), ls, rs)
+		if d > 0 && d <= n {
+			p, ok = n-d, true
+		}
+
+		return
+	}
+
+	for p = n; p > 0; {
+		p--
+		if l, h := t.childrenRange(p); l <= n && n < h {
+			return p, true
+		}
+	}
+
+	return 0, false
+}
+
 // Hoard returns amount of bytes, taken by the implementation, with
 // [radixt.HoardExactly] as interpretation hint.
 func (t Tree) Hoard() (uint, uint) {
@@ -174,6 +204,20 @@ func (t Tree) childrenAmount(n node) uint {
 	return body(n, t[lsChildrenAmount], t[rsChildrenAmount])
 }
 
+// parentShifts returns left and right shifts to extract field of parent from
+// a node with boolean true flag, if nodes of the tree have the field, or
+// default values otherwise. The field takes the gap between amount of children
+// and chunk's length.
+func (t Tree) parentShifts() (ls, rs byte, has bool) {
+	ls = t[lsChildrenAmount]
+	l := int(t[sChunkLen]) + int(ls) - nodeBits
+	if l <= 0 || l > int(ls) {
+		return 0, 0, false
+	}
+
+	return ls - byte(l), byte(nodeBits - l), true
+}
+
 func (t Tree) childrenStart(n uint, no node) uint {
 	return body(no, t[lsChildrenStart], t[rsChildrenStart]) + n + 1
 }
@@ -182,4 +226,5 @@ var (
 	_ radixt.Tree     = Tree("")
 	_ radixt.Hoarder  = Tree("")
 	_ lookup.Switcher = Tree("")
+	_ radixt.Parenter = Tree("")
 )
@@ -0,0 +1,12 @@
+package str4_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+func TestConformance(t *testing.T) {
+	treetest.Conformance(t, treetest.Builder(str4.New))
+}
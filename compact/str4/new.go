@@ -11,18 +11,65 @@ import (
 // it returns new, compactified representation of the tree and nil for error.
 // In case of an error, it returns nil for tree and the error.
 func New(t radixt.Tree) (Tree, error) {
-	a := analysis.Do[analysis.Firstless](t)
+	return create(t, false)
+}
+
+// NewWithParents is like [New], but the nodes of the compactified tree also
+// contain indices of their parents, so method [Tree.Parent] takes constant
+// time. It returns [compact.ErrorOverflow], if the indices do not fit into the
+// nodes.
+func NewWithParents(t radixt.Tree) (Tree, error) {
+	return create(t, true)
+}
+
+// Hoard returns length of the tree, which [New] (or [NewWithParents], if
+// parents is true) would create from a tree with analysis a, that is, amount
+// of memory, which the tree would hoard, and nil error. If the functions would
+// fail, it returns zero with their error instead.
+func Hoard(a analysis.A[analysis.Firstless], parents bool) (uint, error) {
+	_, _, l, err := layout(a, parents)
+	return uint(l), err
+}
+
+// layout returns header, node factory and length of tree, created from a tree
+// with analysis a, and nil error, or default values with error, if the tree
+// can not be created.
+func layout(a analysis.A[analysis.Firstless], parents bool) (
+	h header.A8b,
+	nf header.NodeFactory[uint32, analysis.Firstless],
+	l int,
+	err error,
+) {
+	calc := header.Calc[uint32, analysis.Firstless]
+	if parents {
+		calc = header.CalcWithParents[uint32, analysis.Firstless]
+	}
+
 	size := len(a.N)
 	if size > maskSize {
-		return "", compact.ErrorNodesOverflow
+		err = compact.ErrorNodesOverflow
+		return
+	}
+
+	h, nf, err = calc(nodeLen * 8, a)
+	if err != nil {
+		return
 	}
 
-	h, nf, err := header.Calc[uint32](nodeLen * 8, a)
+	// Every node also has the first byte of its chunk stored separately.
+	l = cfstart + (nodeLen+1)*size + len(a.C)
+	return
+}
+
+func create(t radixt.Tree, parents bool) (Tree, error) {
+	a := analysis.Do[analysis.Firstless](t)
+	h, nf, l, err := layout(a, parents)
 	if err != nil {
 		return "", err
 	}
 
-	bytes := make([]byte, cfstart+(nodeLen+1)*size+len(a.C))
+	size := len(a.N)
+	bytes := make([]byte, l)
 	copy(bytes, h[:])
 
 	emptyRoot := 0
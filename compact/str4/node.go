@@ -5,6 +5,7 @@ type node uint32
 const (
 	maskShift = 0x3F
 	nodeLen = 4
+	nodeBits = 32
 )
 
 func head(n node, s byte) uint {
@@ -0,0 +1,92 @@
+package str4
+
+import (
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/internal/contract"
+)
+
+// Parse validates string s (see [Validate]) and returns it as a tree with nil
+// error. In case of an error, it returns empty tree and the error.
+func Parse(s string) (Tree, error) {
+	if err := Validate(s); err != nil {
+		return "", err
+	}
+
+	return Tree(s), nil
+}
+
+// Validate checks, that string s, which can come, for example, from a file or
+// network, is a valid tree, so methods of the tree do not panic and the tree
+// keeps the contract of [radixt.Tree]. Strings, shorter than [ProperLen], are
+// valid empty trees. It returns nil, if the string is valid, or
+// [*compact.InvalidError] with description of the problem otherwise.
+func Validate(s string) error {
+	t := Tree(s)
+	if t.empty() {
+		return nil
+	}
+
+	for i := sChunkPos; i < h21; i++ {
+		if t[i] > nodeBits {
+			return invalid(-1, "invalid shift")
+		}
+	}
+
+	size := t.Size()
+	if size == 0 && t.emptyRoot() {
+		return invalid(-1, "empty root flag of empty tree")
+	}
+
+	cstart := cfstart + (nodeLen+1)*size
+	if uint(len(t)) < cstart {
+		return invalid(-1, "truncated nodes")
+	}
+
+	chunksLen := uint(len(t)) - cstart
+	for n := uint(0); n < size; n++ {
+		no := t.node(n, size)
+		low := t.chunkPos(no)
+		l := t.chunkLen(no)
+		reason := ""
+		switch {
+		case low > chunksLen || l > chunksLen-low:
+			reason = "invalid chunk range"
+
+		case n == 0 && t.emptyRoot() && (l > 0 || t[cfstart] != 0):
+			reason = "invalid empty root"
+		}
+
+		if reason != "" {
+			return invalid(int(n), reason)
+		}
+	}
+
+	n, reason := contract.Children(size, t.childrenRange, t.first)
+	if _, _, has := t.parentShifts(); reason == "" && has {
+		n, reason = contract.Parents(size, t.childrenRange, t.Parent)
+	}
+
+	if reason != "" {
+		return invalid(int(n), reason)
+	}
+
+	return nil
+}
+
+func (t Tree) childrenRange(n uint) (low, high uint) {
+	no := t.node(n, t.Size())
+	if ca := t.childrenAmount(no); ca > 0 {
+		low = t.childrenStart(n, no)
+		high = low + ca
+	}
+
+	return
+}
+
+func (t Tree) first(n uint) (byte, bool) {
+	return t[cfstart+n], n > 0 || !t.emptyRoot()
+}
+
+func invalid(n int, reason string) error {
+	return &compact.InvalidError{Node: n, Reason: reason}
+}
@@ -0,0 +1,123 @@
+package str4_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/lookup"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var validTrees = []str4.Tree{
+	"",
+	"abc",
+	str4.MustCreate(nil),
+	str4.MustCreate(sapling.New("")),
+	str4.MustCreate(sapling.New("a")),
+	str4.MustCreate(sapling.New("", "a", "ab")),
+	atree,
+	ptree,
+}
+
+const testValidateValidError = "Validate Valid Test %d: got error %v"
+
+func TestValidateValid(t *testing.T) {
+	for i, tree := range validTrees {
+		if err := str4.Validate(string(tree)); err != nil {
+			t.Errorf(testValidateValidError, i, err)
+		}
+	}
+}
+
+func tamper(tree str4.Tree, i int, b byte) string {
+	s := []byte(tree)
+	s[i] = b
+	return string(s)
+}
+
+var validateInvalidTests = []struct {
+	input string
+	node  int
+}{
+	{input: tamper(atree, 0, 33), node: -1},
+	{input: tamper(atree, 9, 0x01), node: -1},
+	{input: string(atree[:str4.ProperLen+5]), node: -1},
+	{input: string(atree[:len(atree)-1]), node: 4},
+	{input: tamper(atree, str4.ProperLen+2, 'a'), node: 2},
+	{input: tamper(atree, str4.ProperLen+4, 'a'), node: 4},
+	{input: tamper(ptree, str4.ProperLen+24, 0x00), node: 3},
+}
+
+const testValidateInvalidError = "Validate Invalid Test %d: got error %v " +
+	"(should be for node %d)"
+
+func TestValidateInvalid(t *testing.T) {
+	for i, tt := range validateInvalidTests {
+		err := str4.Validate(tt.input)
+		var ie *compact.InvalidError
+		e := !errors.As(err, &ie) ||
+			!errors.Is(err, compact.ErrorInvalid) ||
+			ie.Node != tt.node
+
+		if e {
+			t.Errorf(testValidateInvalidError, i, err, tt.node)
+		}
+	}
+}
+
+const testParseError = "Parse Test: got %q and error %v " +
+	"(should be %q and nil)"
+
+func TestParse(t *testing.T) {
+	tree, err := str4.Parse(string(atree))
+	if tree != atree || err != nil {
+		t.Errorf(testParseError, tree, err, atree)
+	}
+
+	tree, err = str4.Parse(string(atree[:len(atree)-1]))
+	if tree != "" || err == nil {
+		t.Errorf(testParseError, tree, err, "")
+	}
+}
+
+// TestValidateTampered sets every byte of valid trees to various values and
+// verifies, that trees, accepted by validation, can be used without panics.
+func TestValidateTampered(t *testing.T) {
+	values := []byte{0x00, 0x01, 0x07, 0x1F, 0x20, 0x21, 0x40, 0x80, 0xFF}
+	for _, tree := range validTrees {
+		for i := 0; i < len(tree); i++ {
+			for _, v := range values {
+				s := tamper(tree, i, v)
+				if str4.Validate(s) == nil {
+					exercise(str4.Tree(s))
+				}
+			}
+		}
+	}
+}
+
+func exercise(t radixt.Tree) {
+	l := lookup.New(t)
+	keys.Each(t, func(key []byte, v uint) bool {
+		l.Reset()
+		for _, b := range key {
+			l.Feed(b)
+		}
+
+		l.Value()
+		return false
+	})
+
+	_ = evident.New(t)
+
+	if p, ok := t.(radixt.Parenter); ok {
+		for n := uint(0); n <= t.Size(); n++ {
+			p.Parent(n)
+		}
+	}
+}
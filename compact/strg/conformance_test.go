@@ -0,0 +1,20 @@
+package strg_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+func TestConformanceN2(t *testing.T) {
+	treetest.Conformance(t, treetest.Builder(strg.New[strg.N2]))
+}
+
+func TestConformanceN3(t *testing.T) {
+	treetest.Conformance(t, treetest.Builder(strg.New[strg.N3]))
+}
+
+func TestConformanceN4(t *testing.T) {
+	treetest.Conformance(t, treetest.Builder(strg.New[strg.N4]))
+}
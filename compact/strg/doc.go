@@ -4,16 +4,21 @@
 //
 // The implementation is aimed to have reduced memory footprint in comparison
 // with generic implementation: The most of node information are contained in
-// just 3 or 4 bytes. As it provides only limited abilities to store chunks and
-// values of tree nodes, it is not aimed to cover all cases of input data. It
-// is totally static and safe to use by multiple goroutines concurrently.
+// just 2, 3 or 4 bytes. As it provides only limited abilities to store chunks
+// and values of tree nodes, it is not aimed to cover all cases of input data.
+// It is totally static and safe to use by multiple goroutines concurrently.
 //
 // The package also provides factory method to create a compactified copy of
 // the provided tree. Also, the copies could be saved as regular Go strings
-// (even constant ones).
+// (even constant ones). Factory method [NewWithParents] creates a copy, which
+// nodes contain indices of their parents in addition.
 //
 // The implementation has the following feature: all strings with length less
 // than [ProperLen] constant are considered valid empty trees. Empty string, of
 // course, is in the category too. Value of the [ProperLen] depends on length
 // of headers, but it must be strictly more than 2 and less than 18.
+//
+// Other strings are not checked by the tree methods, so strings, which come
+// from untrusted sources, like files or network, should be loaded with
+// [Parse], which validates them first.
 package strg
@@ -1,5 +1,9 @@
 package strg
 
+// N2 is 2-bytes array and used to select how many bytes per node is used in
+// tree implementation.
+type N2 [2]byte
+
 // N3 is 3-bytes array and used to select how many bytes per node is used in
 // tree implementation.
 type N3 [3]byte
@@ -12,7 +16,7 @@ type N4 [4]byte
 // Go doesn't support constants as generic parameters, the types are used
 // instead.
 type N interface {
-	N3 | N4
+	N2 | N3 | N4
 }
 
 func bytesLen[n N]() int {
@@ -15,18 +15,63 @@ const maxChunksLen = math.MaxUint16 - cstart
 // it returns new, compactified representation of the tree and nil for error.
 // In case of an error, it returns nil for tree and the error.
 func New[NX N](t radixt.Tree) (Tree[NX], error) {
-	a := analysis.Do[analysis.Default](t)
+	return create[NX](t, false)
+}
+
+// NewWithParents is like [New], but the nodes of the compactified tree also
+// contain indices of their parents, so method [Tree.Parent] takes constant
+// time. It returns [compact.ErrorOverflow], if the indices do not fit into the
+// nodes.
+func NewWithParents[NX N](t radixt.Tree) (Tree[NX], error) {
+	return create[NX](t, true)
+}
+
+// Hoard returns length of the tree, which [New] (or [NewWithParents], if
+// parents is true) would create from a tree with analysis a, that is, amount
+// of memory, which the tree would hoard, and nil error. If the functions would
+// fail, it returns zero with their error instead.
+func Hoard[NX N](a analysis.A[analysis.Default], parents bool) (uint, error) {
+	_, _, l, err := layout[NX](a, parents)
+	return uint(l), err
+}
+
+// layout returns header, node factory and length of tree, created from a tree
+// with analysis a, and nil error, or default values with error, if the tree
+// can not be created.
+func layout[NX N](a analysis.A[analysis.Default], parents bool) (
+	h header.A8b,
+	nf header.NodeFactory[uint32, analysis.Default],
+	l int,
+	err error,
+) {
+	calc := header.Calc[uint32, analysis.Default]
+	if parents {
+		calc = header.CalcWithParents[uint32, analysis.Default]
+	}
+
 	if len(a.C) > maxChunksLen {
-		return "", compact.ErrorChunksOverflow
+		err = compact.ErrorChunksOverflow
+		return
+	}
+
+	h, nf, err = calc(8*bytesLen[NX](), a)
+	if err != nil {
+		return
 	}
 
-	h, nf, err := header.Calc[uint32](8*bytesLen[NX](), a)
+	l = cstart + len(a.C) + bytesLen[NX]()*len(a.N)
+	return
+}
+
+func create[NX N](t radixt.Tree, parents bool) (Tree[NX], error) {
+	a := analysis.Do[analysis.Default](t)
+	h, nf, l, err := layout[NX](a, parents)
 	if err != nil {
 		return "", err
 	}
 
 	noffset := cstart + len(a.C)
-	bytes := make([]byte, noffset+bytesLen[NX]()*len(a.N))
+	bytes := make([]byte, l)
 	copy(bytes, h[:])
 
 	bytes[hlen] = byte(noffset & 0xFF)
@@ -38,11 +83,14 @@ func New[NX N](t radixt.Tree) (Tree[NX], error) {
 		o := noffset + int(n.Index)*bytesLen[NX]()
 		node := nf(n)
 		bytes[o] = byte(node & 0xFF)
-		bytes[o+1] = byte(node >> 8 & 0xFF)
 		switch bytesLen[NX]() {
+		case 2:
+			bytes[o+1] = byte(node >> 8)
 		case 3:
+			bytes[o+1] = byte(node >> 8 & 0xFF)
 			bytes[o+2] = byte(node >> 16)
 		case 4:
+			bytes[o+1] = byte(node >> 8 & 0xFF)
 			bytes[o+2] = byte(node >> 16 & 0xFF)
 			bytes[o+3] = byte(node >> 24)
 		}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/analysis"
 	"github.com/alex-ilchukov/radixt/compact"
 	"github.com/alex-ilchukov/radixt/compact/strg"
 	"github.com/alex-ilchukov/radixt/evident"
@@ -12,6 +13,8 @@ import (
 )
 
 const (
+	border2 = 0x7F - 1
+	large2  = 0x7F
 	border3 = 0x7_FF - 1
 	large3  = 0x7_FF
 	border4 = 0x7_FF_FF - 1
@@ -33,6 +36,20 @@ var (
 		"TRACE",
 	)
 
+	fewValues = sapling.New("GET", "PUT", "HEAD")
+
+	borderValues2 = sapling.NewFromSV(
+		sapling.SV{S: "GET", V: border2},
+		sapling.SV{S: "PUT", V: border2},
+		sapling.SV{S: "HEAD", V: border2},
+	)
+
+	largeValues2 = sapling.NewFromSV(
+		sapling.SV{S: "GET", V: large2},
+		sapling.SV{S: "PUT", V: large2},
+		sapling.SV{S: "HEAD", V: large2},
+	)
+
 	borderValues3 = sapling.NewFromSV(
 		sapling.SV{S: "GET", V: border3},
 		sapling.SV{S: "POST", V: border3},
@@ -82,6 +99,62 @@ var (
 	)
 )
 
+var newError2Tests = []struct {
+	tree    radixt.Tree
+	result2 error
+}{
+	{tree: nil, result2: nil},
+	{tree: emptyOriginal, result2: nil},
+	{tree: null.Tree, result2: nil},
+	{tree: regularValues, result2: compact.ErrorOverflow},
+	{tree: fewValues, result2: nil},
+	{tree: borderValues2, result2: nil},
+	{tree: largeValues2, result2: compact.ErrorOverflow},
+}
+
+const testNewError2Error = "Test New[N2] Error %d: got \"%s\" error " +
+	"(should be \"%s\")"
+
+func TestNewError2(t *testing.T) {
+	for i, tt := range newError2Tests {
+		_, result2 := strg.New[strg.N2](tt.tree)
+		if result2 != tt.result2 {
+			t.Errorf(testNewError2Error, i, result2, tt.result2)
+		}
+	}
+}
+
+var new2Tests = []struct {
+	tree radixt.Tree
+	e    evident.Tree
+}{
+	{tree: nil, e: nil},
+	{tree: emptyOriginal, e: nil},
+	{tree: null.Tree, e: nil},
+	{
+		tree: fewValues,
+		e: evident.Tree{
+			"|": {
+				"GET|0":  nil,
+				"PUT|1":  nil,
+				"HEAD|2": nil,
+			},
+		},
+	},
+}
+
+const testNew2Error = "Test New[N2] %d: got that New(%v) is\n\n%v\n\n" +
+	"which is not equal to\n\n%v\n\n(but should be equal)"
+
+func TestNew2(t *testing.T) {
+	for i, tt := range new2Tests {
+		result, _ := strg.New[strg.N2](tt.tree)
+		if !tt.e.Eq(result) {
+			t.Errorf(testNew2Error, i, tt.tree, result, tt.e)
+		}
+	}
+}
+
 var newError3Tests = []struct {
 	tree    radixt.Tree
 	result2 error
@@ -207,3 +280,47 @@ func TestNew4(t *testing.T) {
 		}
 	}
 }
+
+const testHoardError = "Test Hoard[%s] %d/%t: got %d and \"%v\" error " +
+	"(should be %d and \"%v\")"
+
+func TestHoard(t *testing.T) {
+	testHoard[strg.N2](t, "N2", newError2Tests)
+	testHoard[strg.N3](t, "N3", newError3Tests)
+	testHoard[strg.N4](t, "N4", newError4Tests)
+}
+
+func testHoard[NX strg.N](
+	t *testing.T,
+	name string,
+	tests []struct {
+		tree    radixt.Tree
+		result2 error
+	},
+) {
+	for i, tt := range tests {
+		a := analysis.Do[analysis.Default](tt.tree)
+		for _, parents := range []bool{false, true} {
+			f := strg.New[NX]
+			if parents {
+				f = strg.NewWithParents[NX]
+			}
+
+			tree, err := f(tt.tree)
+			size, _ := tree.Hoard()
+			result1, result2 := strg.Hoard[NX](a, parents)
+			if result1 != size || result2 != err {
+				t.Errorf(
+					testHoardError,
+					name,
+					i,
+					parents,
+					result1,
+					result2,
+					size,
+					err,
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package strg_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/null"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+func mustCreateWithParents[NX strg.N](t radixt.Tree) strg.Tree[NX] {
+	result, err := strg.NewWithParents[NX](t)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+var (
+	authorities = sapling.New(
+		"authority",
+		"authorization",
+		"author",
+		"authentication",
+		"auth",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	)
+
+	ptree2 = mustCreateWithParents[strg.N2](
+		sapling.New("auth", "author", "content"),
+	)
+
+	ptree3 = mustCreateWithParents[strg.N3](authorities)
+	ptree4 = mustCreateWithParents[strg.N4](authorities)
+)
+
+var newWithParentsErrorTests = []struct {
+	tree    radixt.Tree
+	result2 error
+	result3 error
+	result4 error
+}{
+	{tree: nil, result2: nil, result3: nil, result4: nil},
+	{tree: emptyOriginal, result2: nil, result3: nil, result4: nil},
+	{tree: null.Tree, result2: nil, result3: nil, result4: nil},
+	{tree: fewValues, result2: nil, result3: nil, result4: nil},
+	{
+		tree:    borderValues2,
+		result2: compact.ErrorOverflow,
+		result3: nil,
+		result4: nil,
+	},
+	{
+		tree:    regularValues,
+		result2: compact.ErrorOverflow,
+		result3: nil,
+		result4: nil,
+	},
+	{
+		tree:    borderValues3,
+		result2: compact.ErrorOverflow,
+		result3: compact.ErrorOverflow,
+		result4: nil,
+	},
+	{
+		tree:    borderValues4,
+		result2: compact.ErrorOverflow,
+		result3: compact.ErrorOverflow,
+		result4: compact.ErrorOverflow,
+	},
+}
+
+const testNewWithParentsErrorError = "Test New With Parents Error %d: got " +
+	"\"%s\", \"%s\" and \"%s\" errors (should be \"%s\", \"%s\" and " +
+	"\"%s\")"
+
+func TestNewWithParentsError(t *testing.T) {
+	for i, tt := range newWithParentsErrorTests {
+		_, result2 := strg.NewWithParents[strg.N2](tt.tree)
+		_, result3 := strg.NewWithParents[strg.N3](tt.tree)
+		_, result4 := strg.NewWithParents[strg.N4](tt.tree)
+		e := result2 != tt.result2 ||
+			result3 != tt.result3 ||
+			result4 != tt.result4
+
+		if e {
+			t.Errorf(
+				testNewWithParentsErrorError,
+				i,
+				result2,
+				result3,
+				result4,
+				tt.result2,
+				tt.result3,
+				tt.result4,
+			)
+		}
+	}
+}
+
+const testNewWithParentsError = "Test New With Parents %d: got that " +
+	"NewWithParents(%v) is\n\n%v\n\nwhich is not equal to\n\n%v\n\n" +
+	"(but should be equal)"
+
+func TestNewWithParents(t *testing.T) {
+	for i, tt := range new2Tests {
+		result, _ := strg.NewWithParents[strg.N2](tt.tree)
+		if !tt.e.Eq(result) {
+			t.Errorf(
+				testNewWithParentsError,
+				i,
+				tt.tree,
+				result,
+				tt.e,
+			)
+		}
+	}
+
+	for i, tt := range new3Tests {
+		result, _ := strg.NewWithParents[strg.N3](tt.tree)
+		if !tt.e.Eq(result) {
+			t.Errorf(
+				testNewWithParentsError,
+				i,
+				tt.tree,
+				result,
+				tt.e,
+			)
+		}
+	}
+
+	for i, tt := range new4Tests {
+		result, _ := strg.NewWithParents[strg.N4](tt.tree)
+		if !tt.e.Eq(result) {
+			t.Errorf(
+				testNewWithParentsError,
+				i,
+				tt.tree,
+				result,
+				tt.e,
+			)
+		}
+	}
+}
+
+type parenter interface {
+	radixt.Tree
+	radixt.Parenter
+}
+
+var treeParentTests = []parenter{
+	blank2,
+	tooshort2,
+	empty2,
+	atree2,
+	ptree2,
+	mustCreateWithParents[strg.N2](fewValues),
+	blank3,
+	tooshort3,
+	empty3,
+	atree3,
+	ptree3,
+	mustCreateWithParents[strg.N3](sapling.New("", "a", "ab")),
+	mustCreateWithParents[strg.N3](regularValues),
+	blank4,
+	tooshort4,
+	empty4,
+	atree4,
+	ptree4,
+	mustCreateWithParents[strg.N4](sapling.New("")),
+	mustCreateWithParents[strg.N4](regularValues),
+}
+
+const testTreeParentError = "Tree Parent Test %d: got %d and %t for parent " +
+	"of node %d (should be %d and %t)"
+
+func TestTreeParent(t *testing.T) {
+	for i, tree := range treeParentTests {
+		size := tree.Size()
+		parents := make([]uint, size+1)
+		has := make([]bool, size+1)
+		for p := uint(0); p < size; p++ {
+			tree.EachChild(p, func(c uint) bool {
+				parents[c] = p
+				has[c] = true
+				return false
+			})
+		}
+
+		for n := uint(0); n <= size; n++ {
+			p, ok := tree.Parent(n)
+			if p != parents[n] || ok != has[n] {
+				t.Errorf(
+					testTreeParentError,
+					i,
+					p,
+					ok,
+					n,
+					parents[n],
+					has[n],
+				)
+			}
+		}
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"github.com/alex-ilchukov/radixt/lookup"
 )
 
-// Tree is radix tree implementation, which support 3-bytes nodes and 4-bytes
-// nodes.
+// Tree is radix tree implementation, which support 2-bytes nodes, 3-bytes
+// nodes and 4-bytes nodes.
 type Tree[_ N] string
 
 const (
@@ -67,6 +67,31 @@ func (t Tree[N]) EachChild(n uint, e func(uint) bool) {
 	}
 }
 
+// Parent returns parent p of node n with boolean true flag, if the tree has
+// the node and the node is not root, or default unsigned integer with boolean
+// false otherwise. It takes constant time, if the tree is created by
+// [NewWithParents], or time, proportional to difference between indices of the
+// node and its parent, otherwise.
+func (t Tree[_]) Parent(n uint) (p uint, ok bool) {
+	valid, limit := t.valid(n)
+	if !valid || n == 0 {
+		return
+	}
+
+	if header.HasParents[uint32](t) {
+		return header.Parent(n, t.node(limit), t)
+	}
+
+	for p = n; p > 0; {
+		p--
+		if l, h := t.childrenRange(p); l <= n && n < h {
+			return p, true
+		}
+	}
+
+	return 0, false
+}
+
 // Hoard returns amount of bytes, taken by the implementation, with
 // [radixt.HoardExactly] as interpretation hint.
 func (t Tree[_]) Hoard() (uint, uint) {
@@ -126,9 +151,9 @@ func (t Tree[N]) limit(offset int, n uint) int {
 }
 
 func (t Tree[_]) valid(n uint) (result bool, limit int) {
-	if !t.empty() {
+	if n < t.Size() {
 		limit = t.limit(t.nOffset(), n)
-		result = limit <= len(t)
+		result = true
 	}
 
 	return
@@ -136,19 +161,28 @@ func (t Tree[_]) valid(n uint) (result bool, limit int) {
 
 func (t Tree[N]) node(limit int) (result uint32) {
 	i := limit - bytesLen[N]()
-	result = uint32(t[i]) | uint32(t[i+1])<<8 | uint32(t[i+2])<<16
-	if bytesLen[N]() == 4 {
-		result |= uint32(t[i+3]) << 24
+	result = uint32(t[i]) | uint32(t[i+1])<<8
+	switch bytesLen[N]() {
+	case 3:
+		result |= uint32(t[i+2]) << 16
+	case 4:
+		result |= uint32(t[i+2])<<16 | uint32(t[i+3])<<24
 	}
 
 	return
 }
 
 var (
+	_ radixt.Tree     = Tree[N2]("")
+	_ radixt.Hoarder  = Tree[N2]("")
+	_ lookup.Switcher = Tree[N2]("")
+	_ radixt.Parenter = Tree[N2]("")
 	_ radixt.Tree     = Tree[N3]("")
 	_ radixt.Hoarder  = Tree[N3]("")
 	_ lookup.Switcher = Tree[N3]("")
+	_ radixt.Parenter = Tree[N3]("")
 	_ radixt.Tree     = Tree[N4]("")
 	_ radixt.Hoarder  = Tree[N4]("")
 	_ lookup.Switcher = Tree[N4]("")
+	_ radixt.Parenter = Tree[N4]("")
 )
@@ -10,6 +10,14 @@ import (
 )
 
 var (
+	blank2    = strg.Tree[strg.N2]("")
+	tooshort2 = strg.Tree[strg.N2]("123")
+	empty2    = strg.MustCreate[strg.N2](nil)
+
+	atree2 = strg.MustCreate[strg.N2](
+		sapling.New("auth", "author", "authority", "content", "cookie"),
+	)
+
 	blank3    = strg.Tree[strg.N3]("")
 	tooshort3 = strg.Tree[strg.N3]("123")
 	empty3    = strg.MustCreate[strg.N3](nil)
@@ -93,6 +101,7 @@ var tree3ValueTests = []struct {
 	{tree: atree3, n: 9, result1: 0, result2: true},
 	{tree: atree3, n: 10, result1: 1, result2: true},
 	{tree: atree3, n: 100, result1: 0, result2: false},
+	{tree: atree3, n: ^uint(0), result1: 0, result2: false},
 }
 
 const testTree3ValueError = "Tree3 Value Test %d: got %d and %t for value " +
@@ -141,6 +150,7 @@ var tree3ChunkTests = []struct {
 	{tree: atree3, n: 9, result: "ty"},
 	{tree: atree3, n: 10, result: "zation"},
 	{tree: atree3, n: 100, result: ""},
+	{tree: atree3, n: ^uint(0), result: ""},
 }
 
 const testTree3ChunkError = "Tree3 Chunk Test %d: got '%s' for chunk of " +
@@ -221,6 +231,7 @@ var tree3EachChildTests = []struct {
 	{tree: atree3, n: 9, f: eachChild, indices: ""},
 	{tree: atree3, n: 10, f: eachChild, indices: ""},
 	{tree: atree3, n: 100, f: eachChild, indices: ""},
+	{tree: atree3, n: ^uint(0), f: eachChild, indices: ""},
 	{tree: atree3, n: 0, f: eachFirstChild, indices: "1"},
 	{tree: atree3, n: 1, f: eachFirstChild, indices: "3"},
 	{tree: atree3, n: 2, f: eachFirstChild, indices: "5"},
@@ -664,6 +675,7 @@ var tree4ValueTests = []struct {
 	{tree: atree4, n: 9, result1: 0, result2: true},
 	{tree: atree4, n: 10, result1: 1, result2: true},
 	{tree: atree4, n: 100, result1: 0, result2: false},
+	{tree: atree4, n: ^uint(0), result1: 0, result2: false},
 }
 
 const testTree4ValueError = "Tree4 Value Test %d: got %d and %t for value " +
@@ -712,6 +724,7 @@ var tree4ChunkTests = []struct {
 	{tree: atree4, n: 9, result: "ty"},
 	{tree: atree4, n: 10, result: "zation"},
 	{tree: atree4, n: 100, result: ""},
+	{tree: atree4, n: ^uint(0), result: ""},
 }
 
 const testTree4ChunkError = "Tree4 Chunk Test %d: got '%s' for chunk of " +
@@ -768,6 +781,7 @@ var tree4EachChildTests = []struct {
 	{tree: atree4, n: 9, f: eachChild, indices: ""},
 	{tree: atree4, n: 10, f: eachChild, indices: ""},
 	{tree: atree4, n: 100, f: eachChild, indices: ""},
+	{tree: atree4, n: ^uint(0), f: eachChild, indices: ""},
 	{tree: atree4, n: 0, f: eachFirstChild, indices: "1"},
 	{tree: atree4, n: 1, f: eachFirstChild, indices: "3"},
 	{tree: atree4, n: 2, f: eachFirstChild, indices: "5"},
@@ -1162,3 +1176,401 @@ func TestTree4Switch(t *testing.T) {
 		}
 	}
 }
+
+var tree2SizeTests = []struct {
+	tree   strg.Tree[strg.N2]
+	result uint
+}{
+	{tree: blank2, result: 0},
+	{tree: tooshort2, result: 0},
+	{tree: empty2, result: 0},
+	{tree: atree2, result: 7},
+}
+
+const testTree2SizeError = "Tree2 Size Test %d: got %d for size (should be %d)"
+
+func TestTree2Size(t *testing.T) {
+	for i, tt := range tree2SizeTests {
+		result := tt.tree.Size()
+		if result != tt.result {
+			t.Errorf(testTree2SizeError, i, result, tt.result)
+		}
+	}
+}
+
+var tree2ValueTests = []struct {
+	tree    strg.Tree[strg.N2]
+	n       uint
+	result1 uint
+	result2 bool
+}{
+	{tree: blank2, n: 0, result1: 0, result2: false},
+	{tree: blank2, n: 1, result1: 0, result2: false},
+	{tree: blank2, n: 100, result1: 0, result2: false},
+	{tree: tooshort2, n: 0, result1: 0, result2: false},
+	{tree: tooshort2, n: 1, result1: 0, result2: false},
+	{tree: tooshort2, n: 100, result1: 0, result2: false},
+	{tree: empty2, n: 0, result1: 0, result2: false},
+	{tree: empty2, n: 1, result1: 0, result2: false},
+	{tree: empty2, n: 100, result1: 0, result2: false},
+	{tree: atree2, n: 0, result1: 0, result2: false},
+	{tree: atree2, n: 1, result1: 0, result2: true},
+	{tree: atree2, n: 2, result1: 0, result2: false},
+	{tree: atree2, n: 3, result1: 1, result2: true},
+	{tree: atree2, n: 4, result1: 3, result2: true},
+	{tree: atree2, n: 5, result1: 4, result2: true},
+	{tree: atree2, n: 6, result1: 2, result2: true},
+	{tree: atree2, n: 100, result1: 0, result2: false},
+	{tree: atree2, n: ^uint(0), result1: 0, result2: false},
+}
+
+const testTree2ValueError = "Tree2 Value Test %d: got %d and %t for value " +
+	"of node %d (should be %d and %t)"
+
+func TestTree2Value(t *testing.T) {
+	for i, tt := range tree2ValueTests {
+		result1, result2 := tt.tree.Value(tt.n)
+		if result1 != tt.result1 || result2 != tt.result2 {
+			t.Errorf(
+				testTree2ValueError,
+				i,
+				result1,
+				result2,
+				tt.n,
+				tt.result1,
+				tt.result2,
+			)
+		}
+	}
+}
+
+var tree2ChunkTests = []struct {
+	tree   strg.Tree[strg.N2]
+	n      uint
+	result string
+}{
+	{tree: blank2, n: 0, result: ""},
+	{tree: blank2, n: 1, result: ""},
+	{tree: blank2, n: 100, result: ""},
+	{tree: tooshort2, n: 0, result: ""},
+	{tree: tooshort2, n: 1, result: ""},
+	{tree: tooshort2, n: 100, result: ""},
+	{tree: empty2, n: 0, result: ""},
+	{tree: empty2, n: 1, result: ""},
+	{tree: empty2, n: 100, result: ""},
+	{tree: atree2, n: 0, result: ""},
+	{tree: atree2, n: 1, result: "auth"},
+	{tree: atree2, n: 2, result: "co"},
+	{tree: atree2, n: 3, result: "or"},
+	{tree: atree2, n: 4, result: "ntent"},
+	{tree: atree2, n: 5, result: "okie"},
+	{tree: atree2, n: 6, result: "ity"},
+	{tree: atree2, n: 100, result: ""},
+	{tree: atree2, n: ^uint(0), result: ""},
+}
+
+const testTree2ChunkError = "Tree2 Chunk Test %d: got '%s' for chunk of " +
+	"node %d (should be '%s')"
+
+func TestTree2Chunk(t *testing.T) {
+	for i, tt := range tree2ChunkTests {
+		result := tt.tree.Chunk(tt.n)
+		if result != tt.result {
+			t.Errorf(
+				testTree2ChunkError,
+				i,
+				result,
+				tt.n,
+				tt.result,
+			)
+		}
+	}
+}
+
+var tree2EachChildTests = []struct {
+	tree    strg.Tree[strg.N2]
+	n       uint
+	f       func(radixt.Tree, uint) string
+	indices string
+}{
+	{tree: blank2, n: 0, f: eachChild, indices: ""},
+	{tree: blank2, n: 1, f: eachChild, indices: ""},
+	{tree: blank2, n: 100, f: eachChild, indices: ""},
+	{tree: blank2, n: 0, f: eachFirstChild, indices: ""},
+	{tree: blank2, n: 1, f: eachFirstChild, indices: ""},
+	{tree: blank2, n: 100, f: eachFirstChild, indices: ""},
+	{tree: tooshort2, n: 0, f: eachChild, indices: ""},
+	{tree: tooshort2, n: 1, f: eachChild, indices: ""},
+	{tree: tooshort2, n: 100, f: eachChild, indices: ""},
+	{tree: tooshort2, n: 0, f: eachFirstChild, indices: ""},
+	{tree: tooshort2, n: 1, f: eachFirstChild, indices: ""},
+	{tree: tooshort2, n: 100, f: eachFirstChild, indices: ""},
+	{tree: empty2, n: 0, f: eachChild, indices: ""},
+	{tree: empty2, n: 1, f: eachChild, indices: ""},
+	{tree: empty2, n: 100, f: eachChild, indices: ""},
+	{tree: empty2, n: 0, f: eachFirstChild, indices: ""},
+	{tree: empty2, n: 1, f: eachFirstChild, indices: ""},
+	{tree: empty2, n: 100, f: eachFirstChild, indices: ""},
+	{tree: atree2, n: 0, f: eachChild, indices: "1, 2"},
+	{tree: atree2, n: 1, f: eachChild, indices: "3"},
+	{tree: atree2, n: 2, f: eachChild, indices: "4, 5"},
+	{tree: atree2, n: 3, f: eachChild, indices: "6"},
+	{tree: atree2, n: 4, f: eachChild, indices: ""},
+	{tree: atree2, n: 5, f: eachChild, indices: ""},
+	{tree: atree2, n: 6, f: eachChild, indices: ""},
+	{tree: atree2, n: 100, f: eachChild, indices: ""},
+	{tree: atree2, n: ^uint(0), f: eachChild, indices: ""},
+	{tree: atree2, n: 0, f: eachFirstChild, indices: "1"},
+	{tree: atree2, n: 1, f: eachFirstChild, indices: "3"},
+	{tree: atree2, n: 2, f: eachFirstChild, indices: "4"},
+	{tree: atree2, n: 3, f: eachFirstChild, indices: "6"},
+	{tree: atree2, n: 4, f: eachFirstChild, indices: ""},
+	{tree: atree2, n: 5, f: eachFirstChild, indices: ""},
+	{tree: atree2, n: 6, f: eachFirstChild, indices: ""},
+	{tree: atree2, n: 100, f: eachFirstChild, indices: ""},
+}
+
+const testTree2EachChildError = "Tree2 Each Child Test %d: got %s as result " +
+	"indices (should be %s)"
+
+func TestTree2EachChild(t *testing.T) {
+	for i, tt := range tree2EachChildTests {
+		indices := tt.f(tt.tree, tt.n)
+		if indices != tt.indices {
+			t.Errorf(
+				testTree2EachChildError,
+				i,
+				indices,
+				tt.indices,
+			)
+		}
+	}
+}
+
+var tree2HoardTests = []struct {
+	tree    strg.Tree[strg.N2]
+	result1 uint
+	result2 uint
+}{
+	{tree: blank2, result1: 0, result2: radixt.HoardExactly},
+	{tree: tooshort2, result1: 3, result2: radixt.HoardExactly},
+	{tree: empty2, result1: 10, result2: radixt.HoardExactly},
+	{tree: atree2, result1: 44, result2: radixt.HoardExactly},
+}
+
+const testTree2HoardError = "Tree2 Hoard Test %d: got %d and %d (should be " +
+	"%d and %d)"
+
+func TestTree2Hoard(t *testing.T) {
+	for i, tt := range tree2HoardTests {
+		result1, result2 := tt.tree.Hoard()
+		if result1 != tt.result1 || result2 != tt.result2 {
+			t.Errorf(
+				testTree2HoardError,
+				i,
+				result1,
+				result2,
+				tt.result1,
+				tt.result2,
+			)
+		}
+	}
+}
+
+var tree2SwitchTests = []struct {
+	switcher strg.Tree[strg.N2]
+	n        uint
+	b        byte
+	result1  uint
+	result2  string
+	result3  bool
+}{
+	{
+		switcher: blank2,
+		n:        0,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: blank2,
+		n:        1,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: blank2,
+		n:        100,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: tooshort2,
+		n:        0,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: tooshort2,
+		n:        1,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: tooshort2,
+		n:        100,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: empty2,
+		n:        0,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: empty2,
+		n:        1,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: empty2,
+		n:        100,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: atree2,
+		n:        0,
+		b:        97,
+		result1:  1,
+		result2:  "uth",
+		result3:  true,
+	},
+	{
+		switcher: atree2,
+		n:        0,
+		b:        99,
+		result1:  2,
+		result2:  "o",
+		result3:  true,
+	},
+	{
+		switcher: atree2,
+		n:        0,
+		b:        98,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: atree2,
+		n:        1,
+		b:        111,
+		result1:  3,
+		result2:  "r",
+		result3:  true,
+	},
+	{
+		switcher: atree2,
+		n:        1,
+		b:        112,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: atree2,
+		n:        2,
+		b:        110,
+		result1:  4,
+		result2:  "tent",
+		result3:  true,
+	},
+	{
+		switcher: atree2,
+		n:        2,
+		b:        111,
+		result1:  5,
+		result2:  "kie",
+		result3:  true,
+	},
+	{
+		switcher: atree2,
+		n:        2,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: atree2,
+		n:        3,
+		b:        105,
+		result1:  6,
+		result2:  "ty",
+		result3:  true,
+	},
+	{
+		switcher: atree2,
+		n:        6,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+	{
+		switcher: atree2,
+		n:        100,
+		b:        97,
+		result1:  0,
+		result2:  "",
+		result3:  false,
+	},
+}
+
+const testTree2SwitchError = "Tree2 Switch Test %d: got %d, '%s', and %t, " +
+	"trying to switch from node %d by byte %d (should be %d, '%s', and %t)"
+
+func TestTree2Switch(t *testing.T) {
+	for i, tt := range tree2SwitchTests {
+		result1, result2, result3 := tt.switcher.Switch(tt.n, tt.b)
+
+		e := result1 != tt.result1 ||
+			result2 != tt.result2 ||
+			result3 != tt.result3
+
+		if e {
+			t.Errorf(
+				testTree2SwitchError,
+				i,
+				result1,
+				result2,
+				result3,
+				tt.n,
+				tt.b,
+				tt.result1,
+				tt.result2,
+				tt.result3,
+			)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package strg
+
+import (
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/compact/internal/header"
+	"github.com/alex-ilchukov/radixt/internal/contract"
+)
+
+// Parse validates string s (see [Validate]) and returns it as a tree with nil
+// error. In case of an error, it returns empty tree and the error.
+func Parse[NX N](s string) (Tree[NX], error) {
+	if err := Validate[NX](s); err != nil {
+		return "", err
+	}
+
+	return Tree[NX](s), nil
+}
+
+// Validate checks, that string s, which can come, for example, from a file or
+// network, is a valid tree with nodes of type NX, so methods of the tree do
+// not panic and the tree keeps the contract of [radixt.Tree]. Strings, shorter
+// than [ProperLen], are valid empty trees. It returns nil, if the string is
+// valid, or [*compact.InvalidError] with description of the problem otherwise.
+func Validate[NX N](s string) error {
+	t := Tree[NX](s)
+	if t.empty() {
+		return nil
+	}
+
+	for i := 0; i < hlen; i++ {
+		if t[i] > 32 {
+			return invalid(-1, "invalid shift")
+		}
+	}
+
+	offset := t.nOffset()
+	e := offset < cstart ||
+		offset > len(t) ||
+		(len(t)-offset)%bytesLen[NX]() != 0
+
+	if e {
+		return invalid(-1, "invalid offset of nodes")
+	}
+
+	size := t.Size()
+	chunksLen := uint(offset - cstart)
+	for n := uint(0); n < size; n++ {
+		low, l := t.chunk(n)
+		if low > chunksLen || l > chunksLen-low {
+			return invalid(int(n), "invalid chunk range")
+		}
+	}
+
+	n, reason := contract.Children(size, t.childrenRange, t.first)
+	if reason == "" && header.HasParents[uint32](t) {
+		n, reason = contract.Parents(size, t.childrenRange, t.Parent)
+	}
+
+	if reason != "" {
+		return invalid(int(n), reason)
+	}
+
+	return nil
+}
+
+func (t Tree[_]) chunk(n uint) (low, l uint) {
+	no := t.node(t.limit(t.nOffset(), n))
+	return header.ChunkLow(no, t), header.ChunkLen(no, t)
+}
+
+func (t Tree[_]) childrenRange(n uint) (low, high uint) {
+	no := t.node(t.limit(t.nOffset(), n))
+	return header.ChildrenRange(n, no, t)
+}
+
+func (t Tree[_]) first(n uint) (byte, bool) {
+	low, l := t.chunk(n)
+	if l == 0 {
+		return 0, false
+	}
+
+	return t[cstart+low], true
+}
+
+func invalid(n int, reason string) error {
+	return &compact.InvalidError{Node: n, Reason: reason}
+}
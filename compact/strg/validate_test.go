@@ -0,0 +1,165 @@
+package strg_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/lookup"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var validTrees = []string{
+	"",
+	"abc",
+	string(empty3),
+	string(empty4),
+	string(strg.MustCreate[strg.N3](sapling.New(""))),
+	string(strg.MustCreate[strg.N4](sapling.New("a"))),
+	string(strg.MustCreate[strg.N3](sapling.New("", "a", "ab"))),
+	string(atree3),
+	string(atree4),
+	string(ptree3),
+	string(ptree4),
+	string(empty2),
+	string(atree2),
+	string(ptree2),
+}
+
+func validate(s string) error {
+	if err := strg.Validate[strg.N3](s); err != nil {
+		return err
+	}
+
+	return strg.Validate[strg.N4](s)
+}
+
+const testValidateValidError = "Validate Valid Test %d: got error %v"
+
+func TestValidateValid(t *testing.T) {
+	trees := []struct {
+		s        string
+		validate func(s string) error
+	}{
+		{s: validTrees[0], validate: validate},
+		{s: validTrees[1], validate: validate},
+		{s: validTrees[2], validate: strg.Validate[strg.N3]},
+		{s: validTrees[3], validate: strg.Validate[strg.N4]},
+		{s: validTrees[4], validate: strg.Validate[strg.N3]},
+		{s: validTrees[5], validate: strg.Validate[strg.N4]},
+		{s: validTrees[6], validate: strg.Validate[strg.N3]},
+		{s: validTrees[7], validate: strg.Validate[strg.N3]},
+		{s: validTrees[8], validate: strg.Validate[strg.N4]},
+		{s: validTrees[9], validate: strg.Validate[strg.N3]},
+		{s: validTrees[10], validate: strg.Validate[strg.N4]},
+		{s: validTrees[11], validate: strg.Validate[strg.N2]},
+		{s: validTrees[12], validate: strg.Validate[strg.N2]},
+		{s: validTrees[13], validate: strg.Validate[strg.N2]},
+	}
+
+	for i, tt := range trees {
+		if err := tt.validate(tt.s); err != nil {
+			t.Errorf(testValidateValidError, i, err)
+		}
+	}
+}
+
+func tamper(s string, i int, b byte) string {
+	result := []byte(s)
+	result[i] = b
+	return string(result)
+}
+
+var validateInvalidTests = []struct {
+	input string
+	node  int
+}{
+	{input: tamper(string(atree3), 0, 33), node: -1},
+	{input: tamper(string(atree3), 8, 0), node: -1},
+	{input: tamper(string(atree3), 9, 0xFF), node: -1},
+	{input: string(atree3[:len(atree3)-1]), node: -1},
+	{input: string(atree3[:len(atree3)-3]), node: 8},
+	{input: tamper(string(atree3), strg.ProperLen, 'z'), node: 6},
+	{input: tamper(string(ptree3), len(ptree3)-3*8+1, 0x00), node: 3},
+}
+
+const testValidateInvalidError = "Validate Invalid Test %d: got error %v " +
+	"(should be for node %d)"
+
+func TestValidateInvalid(t *testing.T) {
+	for i, tt := range validateInvalidTests {
+		err := strg.Validate[strg.N3](tt.input)
+		var ie *compact.InvalidError
+		e := !errors.As(err, &ie) ||
+			!errors.Is(err, compact.ErrorInvalid) ||
+			ie.Node != tt.node
+
+		if e {
+			t.Errorf(testValidateInvalidError, i, err, tt.node)
+		}
+	}
+}
+
+const testParseError = "Parse Test: got %q and error %v " +
+	"(should be %q and nil)"
+
+func TestParse(t *testing.T) {
+	tree, err := strg.Parse[strg.N4](string(atree4))
+	if tree != atree4 || err != nil {
+		t.Errorf(testParseError, tree, err, atree4)
+	}
+
+	tree, err = strg.Parse[strg.N4](string(atree4[:len(atree4)-1]))
+	if tree != "" || err == nil {
+		t.Errorf(testParseError, tree, err, "")
+	}
+}
+
+// TestValidateTampered sets every byte of valid trees to various values and
+// verifies, that trees, accepted by validation, can be used without panics.
+func TestValidateTampered(t *testing.T) {
+	values := []byte{0x00, 0x01, 0x07, 0x1F, 0x20, 0x21, 0x40, 0x80, 0xFF}
+	for _, tree := range validTrees {
+		for i := 0; i < len(tree); i++ {
+			for _, v := range values {
+				s := tamper(tree, i, v)
+				if strg.Validate[strg.N2](s) == nil {
+					exercise(strg.Tree[strg.N2](s))
+				}
+
+				if strg.Validate[strg.N3](s) == nil {
+					exercise(strg.Tree[strg.N3](s))
+				}
+
+				if strg.Validate[strg.N4](s) == nil {
+					exercise(strg.Tree[strg.N4](s))
+				}
+			}
+		}
+	}
+}
+
+func exercise(t radixt.Tree) {
+	l := lookup.New(t)
+	keys.Each(t, func(key []byte, v uint) bool {
+		l.Reset()
+		for _, b := range key {
+			l.Feed(b)
+		}
+
+		l.Value()
+		return false
+	})
+
+	_ = evident.New(t)
+
+	if p, ok := t.(radixt.Parenter); ok {
+		for n := uint(0); n <= t.Size(); n++ {
+			p.Parent(n)
+		}
+	}
+}
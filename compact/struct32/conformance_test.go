@@ -0,0 +1,12 @@
+package struct32_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/compact/struct32"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+func TestConformance(t *testing.T) {
+	treetest.Conformance(t, treetest.Builder(struct32.New))
+}
@@ -0,0 +1,153 @@
+package struct32
+
+import "testing"
+
+var headTests = []struct {
+	n      node
+	s      byte
+	result uint
+}{
+	{n: 0, s: 0, result: 0},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      255,
+		result: 0,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      32,
+		result: 0,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      27,
+		result: 0b10110,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      16,
+		result: 0b11101111_01110110,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      0,
+		result: 0b10101011_11001101_11101111_01110110,
+	},
+}
+
+const testHeadError = "head Test %d: got %b for result, should be %b"
+
+func TestHead(t *testing.T) {
+	for i, tt := range headTests {
+		result := head(tt.n, tt.s)
+		if result != tt.result {
+			t.Errorf(testHeadError, i, result, tt.result)
+		}
+	}
+}
+
+var tailTests = []struct {
+	n      node
+	s      byte
+	result uint
+}{
+	{n: 0, s: 0, result: 0},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      255,
+		result: 0,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      32,
+		result: 0,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      27,
+		result: 0b10101,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      16,
+		result: 0b10101011_11001101,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		s:      0,
+		result: 0b10101011_11001101_11101111_01110110,
+	},
+}
+
+const testTailError = "tail Test %d: got %b for result, should be %b"
+
+func TestTail(t *testing.T) {
+	for i, tt := range tailTests {
+		result := tail(tt.n, tt.s)
+		if result != tt.result {
+			t.Errorf(testTailError, i, result, tt.result)
+		}
+	}
+}
+
+var bodyTests = []struct {
+	n      node
+	ls     byte
+	rs     byte
+	result uint
+}{
+	{n: 0, ls: 0, rs: 0, result: 0},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		ls:     255,
+		rs:     0,
+		result: 0,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		ls:     32,
+		rs:     0,
+		result: 0,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		ls:     5,
+		rs:     32,
+		result: 0,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		ls:     5,
+		rs:     255,
+		result: 0,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		ls:     22,
+		rs:     27,
+		result: 0b11011,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		ls:     16,
+		rs:     24,
+		result: 0b11101111,
+	},
+	{
+		n:      0b10101011_11001101_11101111_01110110,
+		ls:     0,
+		rs:     0,
+		result: 0b10101011_11001101_11101111_01110110,
+	},
+}
+
+const testBodyError = "body Test %d: got %b for result, should be %b"
+
+func TestBody(t *testing.T) {
+	for i, tt := range bodyTests {
+		result := body(tt.n, tt.ls, tt.rs)
+		if result != tt.result {
+			t.Errorf(testBodyError, i, result, tt.result)
+		}
+	}
+}
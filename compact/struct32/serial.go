@@ -0,0 +1,115 @@
+package struct32
+
+import (
+	"encoding/binary"
+
+	"github.com/alex-ilchukov/radixt/serial"
+)
+
+const (
+	width         = 4
+	flagEmptyRoot = 1
+)
+
+// MarshalBinary returns the tree in binary form of package serial.
+func (t *tree) MarshalBinary() ([]byte, error) {
+	f := serial.Frame{
+		Kind:   serial.KindStruct32,
+		Width:  width,
+		Header: t.s.bytes(),
+		Chunks: t.chunks,
+		Firsts: t.cf,
+		Nodes:  make([]byte, 0, len(t.nodes)*width),
+	}
+
+	if t.emptyRoot {
+		f.Flags = flagEmptyRoot
+	}
+
+	for _, n := range t.nodes {
+		f.Nodes = binary.LittleEndian.AppendUint32(f.Nodes, uint32(n))
+	}
+
+	return f.Encode()
+}
+
+// UnmarshalBinary replaces the tree with the one, decoded from binary form
+// data (see [Decode]). The tree is left intact in case of an error.
+func (t *tree) UnmarshalBinary(data []byte) error {
+	result, err := Decode(data)
+	if err == nil {
+		*t = *result
+	}
+
+	return err
+}
+
+// Decode takes binary form data of a tree, produced by its MarshalBinary
+// method, and returns the decoded tree, ready to use, with nil error. In case
+// of an error, it returns nil for tree and the error, which is one of the
+// errors of package serial or wraps [serial.ErrorCorrupted].
+func Decode(data []byte) (*tree, error) {
+	f, err := serial.Decode(data, serial.KindStruct32, width)
+	if err != nil {
+		return nil, err
+	}
+
+	size := f.Size()
+	e := f.Flags&^flagEmptyRoot != 0 ||
+		(f.Flags == flagEmptyRoot && size == 0) ||
+		uint(len(f.Firsts)) != size
+
+	if e {
+		return nil, serial.ErrorCorrupted
+	}
+
+	for _, s := range f.Header {
+		if s > width*8 {
+			return nil, serial.ErrorCorrupted
+		}
+	}
+
+	t := &tree{
+		emptyRoot: f.Flags == flagEmptyRoot,
+		s:         shiftsOf(f.Header),
+		chunks:    f.Chunks,
+		cf:        f.Firsts,
+		nodes:     make([]node, size),
+	}
+
+	chunksLen := uint(len(t.chunks))
+	for i := uint(0); i < size; i++ {
+		t.nodes[i] = node(binary.LittleEndian.Uint32(f.Node(i)))
+
+		low := t.chunkPos(i)
+		l := t.chunkLen(i)
+		switch {
+		case low > chunksLen || l > chunksLen-low:
+			return nil, serial.Corrupted(i, "invalid chunk range")
+
+		case i == 0 && t.emptyRoot && (l > 0 || t.cf[0] != 0):
+			return nil, serial.Corrupted(i, "invalid empty chunk")
+		}
+	}
+
+	err = serial.CheckChildren(size, t.childrenRange, t.first)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *tree) childrenRange(n uint) (low, high uint) {
+	ca := t.childrenAmount(n)
+	if ca > 0 {
+		low = t.childrenStart(n)
+		high = low + ca
+	}
+
+	return
+}
+
+func (t *tree) first(n uint) (byte, bool) {
+	return t.cf[n], n > 0 || !t.emptyRoot
+}
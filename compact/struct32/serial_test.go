@@ -0,0 +1,81 @@
+package struct32
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/sapling"
+	"github.com/alex-ilchukov/radixt/serial"
+)
+
+// set returns node n with value v of field between left shift ls and right
+// shift rs.
+func set(n node, ls, rs byte, v uint) node {
+	p := rs - ls
+	mask := node(1)<<(32-rs) - 1
+	return n&^(mask<<p) | (node(v)&mask)<<p
+}
+
+const testLoopError = "Loop Test %d/%d: got tree with error %v (should be " +
+	"%v)"
+
+// TestLoop crafts tree with nodes 0 (root), 1 ("a"), 2 ("b") and 3 ("c"),
+// where the root has node 1 as the only child, and node 2 has one or two
+// children with every possible start. The start is kept relative to the node,
+// so it is always greater than index of the node: node 2 can not be child of
+// itself to get a parent, and every such tree is rejected.
+func TestLoop(t *testing.T) {
+	original, err := New(sapling.New("a", "b", "bc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := original.s
+	for start := uint(0); start < 1<<(32-s.rsChildrenStart); start++ {
+		for amount := uint(1); amount <= 2; amount++ {
+			tree := *original
+			tree.nodes = append([]node(nil), original.nodes...)
+			tree.nodes[0] = set(
+				tree.nodes[0],
+				s.lsChildrenAmount,
+				s.rsChildrenAmount,
+				1,
+			)
+
+			tree.nodes[2] = set(
+				tree.nodes[2],
+				s.lsChildrenStart,
+				s.rsChildrenStart,
+				start,
+			)
+
+			tree.nodes[2] = set(
+				tree.nodes[2],
+				s.lsChildrenAmount,
+				s.rsChildrenAmount,
+				amount,
+			)
+
+			data, err := tree.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := Decode(data)
+			if err == nil {
+				err = radixt.Validate(result)
+			}
+
+			if !errors.Is(err, serial.ErrorCorrupted) {
+				t.Errorf(
+					testLoopError,
+					start,
+					amount,
+					err,
+					serial.ErrorCorrupted,
+				)
+			}
+		}
+	}
+}
@@ -10,3 +10,29 @@ type shifts struct {
 	rsChildrenAmount byte
 	sChunkLen        byte
 }
+
+func shiftsOf(b [8]byte) shifts {
+	return shifts{
+		sChunkPos:        b[0],
+		lsValue:          b[1],
+		rsValue:          b[2],
+		lsChildrenStart:  b[3],
+		rsChildrenStart:  b[4],
+		lsChildrenAmount: b[5],
+		rsChildrenAmount: b[6],
+		sChunkLen:        b[7],
+	}
+}
+
+func (s shifts) bytes() [8]byte {
+	return [8]byte{
+		s.sChunkPos,
+		s.lsValue,
+		s.rsValue,
+		s.lsChildrenStart,
+		s.rsChildrenStart,
+		s.lsChildrenAmount,
+		s.rsChildrenAmount,
+		s.sChunkLen,
+	}
+}
@@ -13,6 +13,8 @@ import (
 var (
 	empty = struct32.MustCreate(nil)
 
+	letter = struct32.MustCreate(sapling.New("a"))
+
 	atree = struct32.MustCreate(
 		sapling.New(
 			"authority",
@@ -109,6 +111,7 @@ var treeChunkTests = []struct {
 	{tree: atree, n: 9, result: "ty"},
 	{tree: atree, n: 10, result: "zation"},
 	{tree: atree, n: 100, result: ""},
+	{tree: letter, n: 0, result: "a"},
 }
 
 const testTreeChunkError = "Tree Chunk Test %d: got '%s' for chunk of node " +
@@ -0,0 +1,12 @@
+package struct64_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/compact/struct64"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+func TestConformance(t *testing.T) {
+	treetest.Conformance(t, treetest.Builder(struct64.New))
+}
@@ -10,4 +10,7 @@
 // The package also provides factory method to create a compactified copy of
 // the provided tree. As the tree struct is not exported outside, the
 // implementation assumes that instance is never nil.
+//
+// The tree can be serialized into binary form of package serial with its
+// MarshalBinary method and loaded back, ready to use, with [Decode].
 package struct64
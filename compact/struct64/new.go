@@ -46,6 +46,18 @@ func New(t radixt.Tree) (*tree, error) {
 	return result, nil
 }
 
+// Hoard returns amount of memory, which the tree, created by [New] from a tree
+// with analysis a, would hoard, and nil error. If [New] would fail, it returns
+// zero with its error instead.
+func Hoard(a analysis.A[analysis.Firstless]) (uint, error) {
+	if _, _, err := header.Calc[uint64](64, a); err != nil {
+		return 0, err
+	}
+
+	l := len(a.N)
+	return hoard(len(a.C), l, l), nil
+}
+
 // MustCreate takes the provided tree t and tries to compactify it. In case of
 // success it returns new, compactified representation of the tree. In case of
 // an error, it panics.
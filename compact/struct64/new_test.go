@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/analysis"
 	"github.com/alex-ilchukov/radixt/compact"
 	"github.com/alex-ilchukov/radixt/compact/struct64"
 	"github.com/alex-ilchukov/radixt/evident"
@@ -118,3 +119,22 @@ func TestNew(t *testing.T) {
 		}
 	}
 }
+
+const testHoardError = "Test Hoard %d: got %d and \"%v\" error (should be " +
+	"%d and \"%v\")"
+
+func TestHoard(t *testing.T) {
+	for i, tt := range newErrorTests {
+		tree, err := struct64.New(tt.tree)
+		size := uint(0)
+		if err == nil {
+			size, _ = tree.Hoard()
+		}
+
+		a := analysis.Do[analysis.Firstless](tt.tree)
+		result1, result2 := struct64.Hoard(a)
+		if result1 != size || result2 != err {
+			t.Errorf(testHoardError, i, result1, result2, size, err)
+		}
+	}
+}
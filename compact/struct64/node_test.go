@@ -0,0 +1,154 @@
+package struct64
+
+import "testing"
+
+var headTests = []struct {
+	n      node
+	s      byte
+	result uint
+}{
+	{n: 0, s: 0, result: 0},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		s:      255,
+		result: 0,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		s:      64,
+		result: 0,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		s:      27,
+		result: 0b10110_01010100_00110010_00010000_10011000,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		s: 0,
+		result: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+	},
+}
+
+const testHeadError = "head Test %d: got %b for result, should be %b"
+
+func TestHead(t *testing.T) {
+	for i, tt := range headTests {
+		result := head(tt.n, tt.s)
+		if result != tt.result {
+			t.Errorf(testHeadError, i, result, tt.result)
+		}
+	}
+}
+
+var tailTests = []struct {
+	n      node
+	s      byte
+	result uint
+}{
+	{n: 0, s: 0, result: 0},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		s:      255,
+		result: 0,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		s:      64,
+		result: 0,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		s:      27,
+		result: 0b10101_01111001_10111101_11101110_11001010,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		s: 0,
+		result: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+	},
+}
+
+const testTailError = "tail Test %d: got %b for result, should be %b"
+
+func TestTail(t *testing.T) {
+	for i, tt := range tailTests {
+		result := tail(tt.n, tt.s)
+		if result != tt.result {
+			t.Errorf(testTailError, i, result, tt.result)
+		}
+	}
+}
+
+var bodyTests = []struct {
+	n      node
+	ls     byte
+	rs     byte
+	result uint
+}{
+	{n: 0, ls: 0, rs: 0, result: 0},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		ls:     255,
+		rs:     0,
+		result: 0,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		ls:     64,
+		rs:     0,
+		result: 0,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		ls:     5,
+		rs:     64,
+		result: 0,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		ls:     5,
+		rs:     255,
+		result: 0,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		ls:     22,
+		rs:     27,
+		result: 0b11011_10110010_10100001_10010000_10000100,
+	},
+	{
+		n: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+		ls: 0,
+		rs: 0,
+		result: 0b10101011_11001101_11101111_01110110<<32 |
+			0b01010100_00110010_00010000_10011000,
+	},
+}
+
+const testBodyError = "body Test %d: got %b for result, should be %b"
+
+func TestBody(t *testing.T) {
+	for i, tt := range bodyTests {
+		result := body(tt.n, tt.ls, tt.rs)
+		if result != tt.result {
+			t.Errorf(testBodyError, i, result, tt.result)
+		}
+	}
+}
@@ -76,18 +76,24 @@ func (t *tree) EachChild(n uint, e func(uint) bool) {
 // Hoard returns amount of bytes, taken by the implementation, with
 // [radixt.HoardExactly] as interpretation hint.
 func (t *tree) Hoard() (amount, hint uint) {
-	amount = 8 + // tree.emptyRoot aligned
+	amount = hoard(len(t.chunks), len(t.cf), cap(t.nodes))
+	hint = radixt.HoardExactly
+
+	return
+}
+
+// hoard returns amount of bytes, taken by tree with chunks of length
+// chunksLen, first bytes of chunks of length cfLen and capacity nodesCap of
+// nodes slice.
+func hoard(chunksLen, cfLen, nodesCap int) uint {
+	return 8 + // tree.emptyRoot aligned
 		8 + //  tree.s
 		16 + // tree.chunks
 		16 + // tree.cf
 		24 + // tree.nodes
-		uint(len(t.chunks)) +
-		uint(len(t.cf)) +
-		uint(cap(t.nodes))*8
-
-	hint = radixt.HoardExactly
-
-	return
+		uint(chunksLen) +
+		uint(cfLen) +
+		uint(nodesCap)*8
 }
 
 // Switch takes node n and byte b. If the node belongs to the tree, it looks
@@ -0,0 +1,16 @@
+package structg_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+func TestConformance32(t *testing.T) {
+	treetest.Conformance(t, treetest.Builder(structg.New[uint32]))
+}
+
+func TestConformance64(t *testing.T) {
+	treetest.Conformance(t, treetest.Builder(structg.New[uint64]))
+}
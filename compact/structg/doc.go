@@ -9,5 +9,10 @@
 //
 // The package also provides factory method to create a compactified copy of
 // the provided tree. As the tree struct is not exported outside, the
-// implementation assumes that instance is never nil.
+// implementation assumes that instance is never nil. Factory method
+// [NewWithParents] creates a copy, which nodes contain indices of their
+// parents in addition.
+//
+// The tree can be serialized into binary form of package serial with its
+// MarshalBinary method and loaded back, ready to use, with [Decode].
 package structg
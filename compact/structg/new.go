@@ -11,8 +11,48 @@ import (
 // it returns new, compactified representation of the tree and nil for error.
 // In case of an error, it returns nil for tree and the error.
 func New[N node.N](t radixt.Tree) (*tree[N], error) {
+	return create[N](t, false)
+}
+
+// NewWithParents is like [New], but the nodes of the compactified tree also
+// contain indices of their parents, so method Parent of the tree takes
+// constant time. It returns [compact.ErrorOverflow], if the indices do not
+// fit into the nodes.
+func NewWithParents[N node.N](t radixt.Tree) (*tree[N], error) {
+	return create[N](t, true)
+}
+
+// Hoard returns amount of memory, which the tree, created by [New] (or
+// [NewWithParents], if parents is true) from a tree with analysis a, would
+// hoard, and nil error. If the functions would fail, it returns zero with
+// their error instead.
+func Hoard[N node.N](a analysis.A[analysis.Default], parents bool) (
+	uint,
+	error,
+) {
+	if _, _, err := calc[N](a, parents); err != nil {
+		return 0, err
+	}
+
+	return hoard[N](len(a.C), len(a.N)), nil
+}
+
+func calc[N node.N](a analysis.A[analysis.Default], parents bool) (
+	header.A8b,
+	header.NodeFactory[N, analysis.Default],
+	error,
+) {
+	c := header.Calc[N, analysis.Default]
+	if parents {
+		c = header.CalcWithParents[N, analysis.Default]
+	}
+
+	return c(node.BitsLen[N](), a)
+}
+
+func create[N node.N](t radixt.Tree, parents bool) (*tree[N], error) {
 	a := analysis.Do[analysis.Default](t)
-	h, nf, err := header.Calc[N](node.BitsLen[N](), a)
+	h, nf, err := calc[N](a, parents)
 	if err != nil {
 		return nil, err
 	}
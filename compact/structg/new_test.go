@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/analysis"
 	"github.com/alex-ilchukov/radixt/compact"
 	"github.com/alex-ilchukov/radixt/compact/structg"
 	"github.com/alex-ilchukov/radixt/evident"
@@ -207,3 +208,50 @@ func TestNew64(t *testing.T) {
 		}
 	}
 }
+
+const testHoardError = "Test Hoard[%s] %d/%t: got %d and \"%v\" error " +
+	"(should be %d and \"%v\")"
+
+func TestHoard(t *testing.T) {
+	testHoard[uint32](t, "uint32", newError32Tests)
+	testHoard[uint64](t, "uint64", newError64Tests)
+}
+
+func testHoard[N uint32 | uint64](
+	t *testing.T,
+	name string,
+	tests []struct {
+		tree    radixt.Tree
+		result2 error
+	},
+) {
+	for i, tt := range tests {
+		a := analysis.Do[analysis.Default](tt.tree)
+		for _, parents := range []bool{false, true} {
+			f := structg.New[N]
+			if parents {
+				f = structg.NewWithParents[N]
+			}
+
+			tree, err := f(tt.tree)
+			size := uint(0)
+			if err == nil {
+				size, _ = tree.Hoard()
+			}
+
+			result1, result2 := structg.Hoard[N](a, parents)
+			if result1 != size || result2 != err {
+				t.Errorf(
+					testHoardError,
+					name,
+					i,
+					parents,
+					result1,
+					result2,
+					size,
+					err,
+				)
+			}
+		}
+	}
+}
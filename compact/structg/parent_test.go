@@ -0,0 +1,179 @@
+package structg_test
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/compact/internal/node"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/null"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+type parenter interface {
+	radixt.Tree
+	radixt.Parenter
+	encoding.BinaryMarshaler
+}
+
+func mustCreateWithParents[N node.N](t radixt.Tree) parenter {
+	result, err := structg.NewWithParents[N](t)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+var (
+	authorities = sapling.New(
+		"authority",
+		"authorization",
+		"author",
+		"authentication",
+		"auth",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	)
+
+	ptree32 = mustCreateWithParents[uint32](authorities)
+	ptree64 = mustCreateWithParents[uint64](authorities)
+)
+
+var newWithParentsErrorTests = []struct {
+	tree     radixt.Tree
+	result32 error
+	result64 error
+}{
+	{tree: nil, result32: nil, result64: nil},
+	{tree: emptyOriginal, result32: nil, result64: nil},
+	{tree: null.Tree, result32: nil, result64: nil},
+	{tree: regularValues, result32: nil, result64: nil},
+	{
+		tree:     borderValues32,
+		result32: compact.ErrorOverflow,
+		result64: nil,
+	},
+	{
+		tree:     borderValues64,
+		result32: compact.ErrorOverflow,
+		result64: compact.ErrorOverflow,
+	},
+}
+
+const testNewWithParentsErrorError = "Test New With Parents Error %d: got " +
+	"\"%s\" and \"%s\" errors (should be \"%s\" and \"%s\")"
+
+func TestNewWithParentsError(t *testing.T) {
+	for i, tt := range newWithParentsErrorTests {
+		_, result32 := structg.NewWithParents[uint32](tt.tree)
+		_, result64 := structg.NewWithParents[uint64](tt.tree)
+		if result32 != tt.result32 || result64 != tt.result64 {
+			t.Errorf(
+				testNewWithParentsErrorError,
+				i,
+				result32,
+				result64,
+				tt.result32,
+				tt.result64,
+			)
+		}
+	}
+}
+
+const testNewWithParentsError = "Test New With Parents %d: got that " +
+	"NewWithParents(%v) is\n\n%v\n\nwhich is not equal to\n\n%v\n\n" +
+	"(but should be equal)"
+
+func TestNewWithParents(t *testing.T) {
+	for i, tt := range new32Tests {
+		result, _ := structg.NewWithParents[uint32](tt.tree)
+		if !tt.e.Eq(result) {
+			t.Errorf(
+				testNewWithParentsError,
+				i,
+				tt.tree,
+				result,
+				tt.e,
+			)
+		}
+	}
+
+	for i, tt := range new64Tests {
+		result, _ := structg.NewWithParents[uint64](tt.tree)
+		if !tt.e.Eq(result) {
+			t.Errorf(
+				testNewWithParentsError,
+				i,
+				tt.tree,
+				result,
+				tt.e,
+			)
+		}
+	}
+}
+
+func decoded[N node.N](t parenter) parenter {
+	data, err := t.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+
+	result, err := structg.Decode[N](data)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+var treeParentTests = []parenter{
+	empty32,
+	atree32,
+	ptree32,
+	decoded[uint32](ptree32),
+	mustCreateWithParents[uint32](sapling.New("", "a", "ab")),
+	mustCreateWithParents[uint32](regularValues),
+	empty64,
+	atree64,
+	ptree64,
+	decoded[uint64](ptree64),
+	mustCreateWithParents[uint64](sapling.New("")),
+	mustCreateWithParents[uint64](regularValues),
+}
+
+const testTreeParentError = "Tree Parent Test %d: got %d and %t for parent " +
+	"of node %d (should be %d and %t)"
+
+func TestTreeParent(t *testing.T) {
+	for i, tree := range treeParentTests {
+		size := tree.Size()
+		parents := make([]uint, size+1)
+		has := make([]bool, size+1)
+		for p := uint(0); p < size; p++ {
+			tree.EachChild(p, func(c uint) bool {
+				parents[c] = p
+				has[c] = true
+				return false
+			})
+		}
+
+		for n := uint(0); n <= size; n++ {
+			p, ok := tree.Parent(n)
+			if p != parents[n] || ok != has[n] {
+				t.Errorf(
+					testTreeParentError,
+					i,
+					p,
+					ok,
+					n,
+					parents[n],
+					has[n],
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package structg
+
+import (
+	"encoding/binary"
+
+	"github.com/alex-ilchukov/radixt/compact/internal/header"
+	"github.com/alex-ilchukov/radixt/compact/internal/node"
+	"github.com/alex-ilchukov/radixt/serial"
+)
+
+// MarshalBinary returns the tree in binary form of package serial.
+func (t *tree[N]) MarshalBinary() ([]byte, error) {
+	w := node.BitsLen[N]() / 8
+	f := serial.Frame{
+		Kind:   serial.KindStructg,
+		Width:  byte(w),
+		Header: t.h,
+		Chunks: t.chunks,
+		Nodes:  make([]byte, 0, len(t.nodes)*w),
+	}
+
+	le := binary.LittleEndian
+	for _, n := range t.nodes {
+		if w == 4 {
+			f.Nodes = le.AppendUint32(f.Nodes, uint32(n))
+		} else {
+			f.Nodes = le.AppendUint64(f.Nodes, uint64(n))
+		}
+	}
+
+	return f.Encode()
+}
+
+// UnmarshalBinary replaces the tree with the one, decoded from binary form
+// data (see [Decode]). The tree is left intact in case of an error.
+func (t *tree[N]) UnmarshalBinary(data []byte) error {
+	result, err := Decode[N](data)
+	if err == nil {
+		*t = *result
+	}
+
+	return err
+}
+
+// Decode takes binary form data of a tree with nodes of type N, produced by
+// its MarshalBinary method, and returns the decoded tree, ready to use, with
+// nil error. In case of an error, it returns nil for tree and the error,
+// which is one of the errors of package serial or wraps
+// [serial.ErrorCorrupted].
+func Decode[N node.N](data []byte) (*tree[N], error) {
+	bits := node.BitsLen[N]()
+	w := bits / 8
+	f, err := serial.Decode(data, serial.KindStructg, byte(w))
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Flags != 0 || f.Firsts != "" {
+		return nil, serial.ErrorCorrupted
+	}
+
+	for _, s := range f.Header {
+		if int(s) > bits {
+			return nil, serial.ErrorCorrupted
+		}
+	}
+
+	size := f.Size()
+	t := &tree[N]{
+		h:      header.A8b(f.Header),
+		chunks: f.Chunks,
+		nodes:  make([]N, size),
+	}
+
+	chunksLen := uint(len(t.chunks))
+	for i := uint(0); i < size; i++ {
+		b := f.Node(i)
+		if w == 4 {
+			t.nodes[i] = N(binary.LittleEndian.Uint32(b))
+		} else {
+			t.nodes[i] = N(binary.LittleEndian.Uint64(b))
+		}
+
+		low := header.ChunkLow(t.nodes[i], t.h)
+		l := header.ChunkLen(t.nodes[i], t.h)
+		if low > chunksLen || l > chunksLen-low {
+			return nil, serial.Corrupted(i, "invalid chunk range")
+		}
+	}
+
+	err = serial.CheckChildren(size, t.childrenRange, t.first)
+	if err == nil && header.HasParents[N](t.h) {
+		err = serial.CheckParents(size, t.childrenRange, t.Parent)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *tree[_]) first(n uint) (byte, bool) {
+	low, high := header.ChunkRange(t.nodes[n], t.h)
+	if low == high {
+		return 0, false
+	}
+
+	return t.chunks[low], true
+}
@@ -51,20 +51,49 @@ func (t *tree[_]) EachChild(n uint, e func(uint) bool) {
 	}
 }
 
+// Parent returns parent p of node n with boolean true flag, if the tree has
+// the node and the node is not root, or default unsigned integer with boolean
+// false otherwise. It takes constant time, if the tree is created by
+// [NewWithParents], or time, proportional to difference between indices of the
+// node and its parent, otherwise.
+func (t *tree[N]) Parent(n uint) (p uint, ok bool) {
+	if n == 0 || n >= t.Size() {
+		return
+	}
+
+	if header.HasParents[N](t.h) {
+		return header.Parent(n, t.nodes[n], t.h)
+	}
+
+	for p = n; p > 0; {
+		p--
+		if l, h := t.childrenRange(p); l <= n && n < h {
+			return p, true
+		}
+	}
+
+	return 0, false
+}
+
 // Hoard returns amount of bytes, taken by the implementation, with
 // [radixt.HoardExactly] as interpretation hint.
 func (t *tree[N]) Hoard() (amount, hint uint) {
-	amount = header.Len +
-		16 + // tree.chunks
-		24 + // tree.nodes
-		uint(len(t.chunks)) +
-		uint(cap(t.nodes))*(uint(node.BitsLen[N]())/8)
-
+	amount = hoard[N](len(t.chunks), cap(t.nodes))
 	hint = radixt.HoardExactly
 
 	return
 }
 
+// hoard returns amount of bytes, taken by tree with chunks of length chunksLen
+// and capacity nodesCap of nodes slice.
+func hoard[N node.N](chunksLen, nodesCap int) uint {
+	return header.Len +
+		16 + // tree.chunks
+		24 + // tree.nodes
+		uint(chunksLen) +
+		uint(nodesCap)*(uint(node.BitsLen[N]())/8)
+}
+
 // Switch takes node n and byte b. If the node belongs to the tree, it looks
 // for a child c of the node with such a chunk, that its first byte coincides
 // with b. If such a child is found, it returns the child with its chunk
@@ -103,7 +132,9 @@ var (
 	_ radixt.Tree     = (*tree[uint32])(nil)
 	_ radixt.Hoarder  = (*tree[uint32])(nil)
 	_ lookup.Switcher = (*tree[uint32])(nil)
+	_ radixt.Parenter = (*tree[uint32])(nil)
 	_ radixt.Tree     = (*tree[uint64])(nil)
 	_ radixt.Hoarder  = (*tree[uint64])(nil)
 	_ lookup.Switcher = (*tree[uint64])(nil)
+	_ radixt.Parenter = (*tree[uint64])(nil)
 )
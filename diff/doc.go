@@ -0,0 +1,11 @@
+// Package diff compares keys with values of two radix trees and reports the
+// differences: keys, which only one of the trees has, and keys with different
+// values. The trees are walked in parallel chunk by chunk without building
+// any intermediate structures, so the comparison works with any
+// implementations of [radixt.Tree] interface, and the differences are
+// reported in byte-lexicographic order of the keys.
+//
+// Function [Each] yields the differences to a user function, and function
+// [Do] gathers them into [Result], which can be both inspected by a program
+// and printed as a human-readable report, for example, on test failure.
+package diff
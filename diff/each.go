@@ -0,0 +1,61 @@
+package diff
+
+import (
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/internal/lockstep"
+)
+
+// Each calls function e just once for every difference between the provided
+// trees a and b in byte-lexicographic order of keys, until the function
+// returns boolean truth. The function gets kind of the difference, the key
+// and values of the key in a and b; the value is zero for the tree, which
+// does not have the key. Nil values of a and b are interpreted as empty trees.
+// The key slice is reused between the calls, so it is valid only until e
+// returns.
+func Each(a, b radixt.Tree, e func(k Kind, key []byte, v, w uint) bool) {
+	d := differ{e: e}
+	d.walk(lockstep.Root(a), lockstep.Root(b))
+}
+
+type differ struct {
+	e   func(k Kind, key []byte, v, w uint) bool
+	key []byte
+}
+
+// walk goes from the positions of cursors x and y, which are over trees a and
+// b respectively and correspond to the same key, and reports the differences
+// in the subtrees. It returns boolean truth, if the user function has asked
+// to stop.
+func (d *differ) walk(x, y lockstep.Cursor) (stop bool) {
+	if x.T == nil && y.T == nil {
+		return
+	}
+
+	l := lockstep.Prefix(x, y)
+	chunk := x.Rest
+	if x.T == nil {
+		chunk = y.Rest
+	}
+
+	key := len(d.key)
+	d.key = append(d.key, chunk[:l]...)
+
+	v, inA := x.Value(l)
+	w, inB := y.Value(l)
+	switch {
+	case inA && inB && v != w:
+		stop = d.e(Changed, d.key, v, w)
+	case inA && !inB:
+		stop = d.e(OnlyA, d.key, v, 0)
+	case !inA && inB:
+		stop = d.e(OnlyB, d.key, 0, w)
+	}
+
+	if !stop {
+		stop = lockstep.Next(x, y, l, d.walk)
+	}
+
+	d.key = d.key[:key]
+
+	return
+}
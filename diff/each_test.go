@@ -0,0 +1,113 @@
+package diff_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/diff"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var (
+	atree = sapling.NewFromSV(
+		sapling.SV{S: "authority", V: 1},
+		sapling.SV{S: "author", V: 2},
+		sapling.SV{S: "content-length", V: 3},
+		sapling.SV{S: "content-type", V: 4},
+	)
+
+	btree = str4.MustCreate(sapling.NewFromSV(
+		sapling.SV{S: "auth", V: 10},
+		sapling.SV{S: "author", V: 2},
+		sapling.SV{S: "content-type", V: 40},
+		sapling.SV{S: "x-request-id", V: 50},
+	))
+
+	withBlank = generic.New(sapling.NewFromSV(
+		sapling.SV{S: "", V: 100},
+		sapling.SV{S: "author", V: 2},
+	))
+)
+
+func each(a, b radixt.Tree, limit int) (result string) {
+	diff.Each(a, b, func(k diff.Kind, key []byte, v, w uint) bool {
+		result += fmt.Sprintf("%s %q %d %d; ", k, key, v, w)
+		limit--
+		return limit == 0
+	})
+
+	return
+}
+
+var eachTests = []struct {
+	a      radixt.Tree
+	b      radixt.Tree
+	limit  int
+	result string
+}{
+	{a: nil, b: nil, limit: -1, result: ""},
+	{a: sapling.New(), b: nil, limit: -1, result: ""},
+	{a: atree, b: atree, limit: -1, result: ""},
+	{a: atree, b: generic.New(atree), limit: -1, result: ""},
+	{
+		a:     atree,
+		b:     nil,
+		limit: -1,
+		result: `only in A "author" 2 0; only in A "authority" 1 0; ` +
+			`only in A "content-length" 3 0; ` +
+			`only in A "content-type" 4 0; `,
+	},
+	{
+		a:      nil,
+		b:      withBlank,
+		limit:  -1,
+		result: `only in B "" 0 100; only in B "author" 0 2; `,
+	},
+	{
+		a:     atree,
+		b:     btree,
+		limit: -1,
+		result: `only in B "auth" 0 10; only in A "authority" 1 0; ` +
+			`only in A "content-length" 3 0; ` +
+			`changed "content-type" 4 40; ` +
+			`only in B "x-request-id" 0 50; `,
+	},
+	{
+		a:     btree,
+		b:     atree,
+		limit: -1,
+		result: `only in A "auth" 10 0; only in B "authority" 0 1; ` +
+			`only in B "content-length" 0 3; ` +
+			`changed "content-type" 40 4; ` +
+			`only in A "x-request-id" 50 0; `,
+	},
+	{
+		a:      atree,
+		b:      btree,
+		limit:  2,
+		result: `only in B "auth" 0 10; only in A "authority" 1 0; `,
+	},
+	{
+		a:     withBlank,
+		b:     btree,
+		limit: -1,
+		result: `only in A "" 100 0; only in B "auth" 0 10; ` +
+			`only in B "content-type" 0 40; ` +
+			`only in B "x-request-id" 0 50; `,
+	},
+}
+
+const testEachError = "Each Test %d: got\n\n%s\n\nfor differences " +
+	"(should be\n\n%s\n\n)"
+
+func TestEach(t *testing.T) {
+	for i, tt := range eachTests {
+		result := each(tt.a, tt.b, tt.limit)
+		if result != tt.result {
+			t.Errorf(testEachError, i, result, tt.result)
+		}
+	}
+}
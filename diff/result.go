@@ -0,0 +1,120 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alex-ilchukov/radixt"
+)
+
+// Kind is kind of difference between two trees.
+type Kind int
+
+const (
+	// OnlyA is kind of key, which only the first tree has.
+	OnlyA Kind = iota
+
+	// OnlyB is kind of key, which only the second tree has.
+	OnlyB
+
+	// Changed is kind of key, which both trees have with different values.
+	Changed
+)
+
+// String returns human-readable representation of kind k.
+func (k Kind) String() string {
+	switch k {
+	case OnlyA:
+		return "only in A"
+	case OnlyB:
+		return "only in B"
+	case Changed:
+		return "changed"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Entry represents a difference between two trees: its kind, the key and
+// values of the key in the first and the second trees. The value is zero for
+// the tree, which does not have the key.
+type Entry struct {
+	Kind Kind
+	Key  string
+	A    uint
+	B    uint
+}
+
+// String returns human-readable representation of entry e.
+func (e Entry) String() string {
+	switch e.Kind {
+	case OnlyA:
+		return fmt.Sprintf("- %q: %d", e.Key, e.A)
+	case OnlyB:
+		return fmt.Sprintf("+ %q: %d", e.Key, e.B)
+	default:
+		return fmt.Sprintf("~ %q: %d -> %d", e.Key, e.A, e.B)
+	}
+}
+
+// Result is a list of differences between two trees in byte-lexicographic
+// order of keys. Empty result means, that the trees have the same keys with
+// the same values.
+type Result []Entry
+
+// Do returns all the differences between the provided trees a and b. See
+// [Each] for more details.
+func Do(a, b radixt.Tree) (r Result) {
+	Each(a, b, func(k Kind, key []byte, v, w uint) bool {
+		r = append(r, Entry{Kind: k, Key: string(key), A: v, B: w})
+		return false
+	})
+
+	return
+}
+
+// Count returns amounts of keys only in the first tree, keys only in the
+// second tree and keys with different values in result r.
+func (r Result) Count() (onlyA, onlyB, changed int) {
+	for _, e := range r {
+		switch e.Kind {
+		case OnlyA:
+			onlyA++
+		case OnlyB:
+			onlyB++
+		case Changed:
+			changed++
+		}
+	}
+
+	return
+}
+
+// String returns human-readable report on result r: a line with amounts of
+// the differences by kind followed by lines with the differences, where keys
+// only in the first tree are marked with minus sign, keys only in the second
+// tree are marked with plus sign, and keys with different values are marked
+// with tilde.
+func (r Result) String() string {
+	if len(r) == 0 {
+		return "no differences"
+	}
+
+	onlyA, onlyB, changed := r.Count()
+
+	b := new(strings.Builder)
+	fmt.Fprintf(
+		b,
+		"%d only in A, %d only in B, %d changed",
+		onlyA,
+		onlyB,
+		changed,
+	)
+
+	for _, e := range r {
+		b.WriteByte('\n')
+		b.WriteString(e.String())
+	}
+
+	return b.String()
+}
@@ -0,0 +1,132 @@
+package diff_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/diff"
+)
+
+var doTests = []struct {
+	a      radixt.Tree
+	b      radixt.Tree
+	result diff.Result
+}{
+	{a: nil, b: nil, result: nil},
+	{a: atree, b: atree, result: nil},
+	{
+		a: atree,
+		b: btree,
+		result: diff.Result{
+			{Kind: diff.OnlyB, Key: "auth", A: 0, B: 10},
+			{Kind: diff.OnlyA, Key: "authority", A: 1, B: 0},
+			{Kind: diff.OnlyA, Key: "content-length", A: 3, B: 0},
+			{Kind: diff.Changed, Key: "content-type", A: 4, B: 40},
+			{Kind: diff.OnlyB, Key: "x-request-id", A: 0, B: 50},
+		},
+	},
+}
+
+const testDoError = "Do Test %d: got %v (should be %v)"
+
+func TestDo(t *testing.T) {
+	for i, tt := range doTests {
+		result := diff.Do(tt.a, tt.b)
+		if !reflect.DeepEqual(result, tt.result) {
+			t.Errorf(testDoError, i, result, tt.result)
+		}
+	}
+}
+
+var resultCountTests = []struct {
+	r       diff.Result
+	onlyA   int
+	onlyB   int
+	changed int
+}{
+	{r: nil, onlyA: 0, onlyB: 0, changed: 0},
+	{r: diff.Do(atree, btree), onlyA: 2, onlyB: 2, changed: 1},
+	{r: diff.Do(atree, nil), onlyA: 4, onlyB: 0, changed: 0},
+	{r: diff.Do(nil, withBlank), onlyA: 0, onlyB: 2, changed: 0},
+}
+
+const testResultCountError = "Result Count Test %d: got %d, %d and %d " +
+	"(should be %d, %d and %d)"
+
+func TestResultCount(t *testing.T) {
+	for i, tt := range resultCountTests {
+		onlyA, onlyB, changed := tt.r.Count()
+		e := onlyA != tt.onlyA ||
+			onlyB != tt.onlyB ||
+			changed != tt.changed
+
+		if e {
+			t.Errorf(
+				testResultCountError,
+				i,
+				onlyA,
+				onlyB,
+				changed,
+				tt.onlyA,
+				tt.onlyB,
+				tt.changed,
+			)
+		}
+	}
+}
+
+var resultStringTests = []struct {
+	r      diff.Result
+	result string
+}{
+	{r: nil, result: "no differences"},
+	{
+		r: diff.Do(atree, btree),
+		result: "2 only in A, 2 only in B, 1 changed\n" +
+			"+ \"auth\": 10\n" +
+			"- \"authority\": 1\n" +
+			"- \"content-length\": 3\n" +
+			"~ \"content-type\": 4 -> 40\n" +
+			"+ \"x-request-id\": 50",
+	},
+	{
+		r: diff.Do(withBlank, nil),
+		result: "2 only in A, 0 only in B, 0 changed\n" +
+			"- \"\": 100\n" +
+			"- \"author\": 2",
+	},
+}
+
+const testResultStringError = "Result String Test %d: got\n\n%s\n\n(should " +
+	"be\n\n%s\n\n)"
+
+func TestResultString(t *testing.T) {
+	for i, tt := range resultStringTests {
+		result := tt.r.String()
+		if result != tt.result {
+			t.Errorf(testResultStringError, i, result, tt.result)
+		}
+	}
+}
+
+var kindStringTests = []struct {
+	k      diff.Kind
+	result string
+}{
+	{k: diff.OnlyA, result: "only in A"},
+	{k: diff.OnlyB, result: "only in B"},
+	{k: diff.Changed, result: "changed"},
+	{k: diff.Kind(100), result: "Kind(100)"},
+}
+
+const testKindStringError = "Kind String Test %d: got %q (should be %q)"
+
+func TestKindString(t *testing.T) {
+	for i, tt := range kindStringTests {
+		result := tt.k.String()
+		if result != tt.result {
+			t.Errorf(testKindStringError, i, result, tt.result)
+		}
+	}
+}
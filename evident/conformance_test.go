@@ -0,0 +1,15 @@
+package evident_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+func TestConformance(t *testing.T) {
+	treetest.Conformance(t, func(t radixt.Tree) (radixt.Tree, error) {
+		return evident.New(t), nil
+	})
+}
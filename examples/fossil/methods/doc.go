@@ -0,0 +1,4 @@
+// Package methods is an example of fossilized radix tree of HTTP methods.
+package methods
+
+//go:generate go run ../../../cmd/fossilize -in methods.txt -type Methods -test
@@ -0,0 +1,195 @@
+// Code generated by github.com/alex-ilchukov/radixt/fossil. DO NOT EDIT.
+
+package methods
+
+// Methods contains information on state of the fossilized lookup process.
+type Methods struct {
+	n    uint
+	pos  uint
+	keep bool
+}
+
+// Reset resets the lookup state.
+func (l *Methods) Reset() {
+	l.n = 0
+	l.pos = 0
+	l.keep = true
+}
+
+// Feed takes byte b and returns if the byte is found in the fossilized
+// tree accordingly to the state or not.
+func (l *Methods) Feed(b byte) bool {
+	if !l.keep {
+		return false
+	}
+
+	switch l.n {
+	case 0: // ""
+		switch b {
+		case 'C':
+			l.n, l.pos = 1, 1
+		case 'D':
+			l.n, l.pos = 2, 1
+		case 'G':
+			l.n, l.pos = 3, 1
+		case 'H':
+			l.n, l.pos = 4, 1
+		case 'O':
+			l.n, l.pos = 5, 1
+		case 'P':
+			l.n, l.pos = 6, 1
+		case 'T':
+			l.n, l.pos = 7, 1
+		default:
+			l.keep = false
+		}
+	case 1: // "CONNECT"
+		if l.pos < 7 {
+			l.keep = b == "CONNECT"[l.pos]
+			l.pos++
+			break
+		}
+
+		l.keep = false
+	case 2: // "DELETE"
+		if l.pos < 6 {
+			l.keep = b == "DELETE"[l.pos]
+			l.pos++
+			break
+		}
+
+		l.keep = false
+	case 3: // "GET"
+		if l.pos < 3 {
+			l.keep = b == "GET"[l.pos]
+			l.pos++
+			break
+		}
+
+		l.keep = false
+	case 4: // "HEAD"
+		if l.pos < 4 {
+			l.keep = b == "HEAD"[l.pos]
+			l.pos++
+			break
+		}
+
+		l.keep = false
+	case 5: // "OPTIONS"
+		if l.pos < 7 {
+			l.keep = b == "OPTIONS"[l.pos]
+			l.pos++
+			break
+		}
+
+		l.keep = false
+	case 6: // "P"
+		if l.pos < 1 {
+			l.keep = b == "P"[l.pos]
+			l.pos++
+			break
+		}
+
+		switch b {
+		case 'A':
+			l.n, l.pos = 8, 1
+		case 'O':
+			l.n, l.pos = 9, 1
+		case 'U':
+			l.n, l.pos = 10, 1
+		default:
+			l.keep = false
+		}
+	case 7: // "TRACE"
+		if l.pos < 5 {
+			l.keep = b == "TRACE"[l.pos]
+			l.pos++
+			break
+		}
+
+		l.keep = false
+	case 8: // "ATCH"
+		if l.pos < 4 {
+			l.keep = b == "ATCH"[l.pos]
+			l.pos++
+			break
+		}
+
+		l.keep = false
+	case 9: // "OST"
+		if l.pos < 3 {
+			l.keep = b == "OST"[l.pos]
+			l.pos++
+			break
+		}
+
+		l.keep = false
+	case 10: // "UT"
+		if l.pos < 2 {
+			l.keep = b == "UT"[l.pos]
+			l.pos++
+			break
+		}
+
+		l.keep = false
+	}
+
+	return l.keep
+}
+
+// Found returns if the lookup state points to key with value in the fossilized
+// tree or not.
+func (l *Methods) Found() bool {
+	_, found := l.Value()
+	return found
+}
+
+// Value returns value v of the key with boolean true flag, if the lookup state
+// points to key with value in the fossilized tree, or default unsigned
+// integer with boolean false otherwise.
+func (l *Methods) Value() (v uint, found bool) {
+	if !l.keep {
+		return
+	}
+
+	switch l.n {
+	case 1:
+		if l.pos == 7 {
+			return 6, true
+		}
+	case 2:
+		if l.pos == 6 {
+			return 3, true
+		}
+	case 3:
+		if l.pos == 3 {
+			return 0, true
+		}
+	case 4:
+		if l.pos == 4 {
+			return 7, true
+		}
+	case 5:
+		if l.pos == 7 {
+			return 5, true
+		}
+	case 7:
+		if l.pos == 5 {
+			return 8, true
+		}
+	case 8:
+		if l.pos == 4 {
+			return 2, true
+		}
+	case 9:
+		if l.pos == 3 {
+			return 1, true
+		}
+	case 10:
+		if l.pos == 2 {
+			return 4, true
+		}
+	}
+
+	return
+}
@@ -0,0 +1,79 @@
+// Code generated by github.com/alex-ilchukov/radixt/fossil. DO NOT EDIT.
+
+package methods
+
+import "testing"
+
+var fossilMethodsTests = []struct {
+	input string
+	fed   bool
+	value uint
+	found bool
+}{
+	{input: "", fed: true, value: 0, found: false},
+	{input: "\x00", fed: false, value: 0, found: false},
+	{input: "CONNECT", fed: true, value: 6, found: true},
+	{input: "CONNEC", fed: true, value: 0, found: false},
+	{input: "CONNECT\x00", fed: false, value: 0, found: false},
+	{input: "DELETE", fed: true, value: 3, found: true},
+	{input: "DELET", fed: true, value: 0, found: false},
+	{input: "DELETE\x00", fed: false, value: 0, found: false},
+	{input: "GET", fed: true, value: 0, found: true},
+	{input: "GE", fed: true, value: 0, found: false},
+	{input: "GET\x00", fed: false, value: 0, found: false},
+	{input: "HEAD", fed: true, value: 7, found: true},
+	{input: "HEA", fed: true, value: 0, found: false},
+	{input: "HEAD\x00", fed: false, value: 0, found: false},
+	{input: "OPTIONS", fed: true, value: 5, found: true},
+	{input: "OPTION", fed: true, value: 0, found: false},
+	{input: "OPTIONS\x00", fed: false, value: 0, found: false},
+	{input: "P", fed: true, value: 0, found: false},
+	{input: "P\x00", fed: false, value: 0, found: false},
+	{input: "TRACE", fed: true, value: 8, found: true},
+	{input: "TRAC", fed: true, value: 0, found: false},
+	{input: "TRACE\x00", fed: false, value: 0, found: false},
+	{input: "PATCH", fed: true, value: 2, found: true},
+	{input: "PATC", fed: true, value: 0, found: false},
+	{input: "PATCH\x00", fed: false, value: 0, found: false},
+	{input: "POST", fed: true, value: 1, found: true},
+	{input: "POS", fed: true, value: 0, found: false},
+	{input: "POST\x00", fed: false, value: 0, found: false},
+	{input: "PUT", fed: true, value: 4, found: true},
+	{input: "PU", fed: true, value: 0, found: false},
+	{input: "PUT\x00", fed: false, value: 0, found: false},
+}
+
+const testFossilMethodsError = "Fossil Methods Test %d: " +
+	"for input %q got %t, %d and %t " +
+	"(should be %t, %d and %t)"
+
+func TestFossilMethods(t *testing.T) {
+	var l Methods
+	for i, tt := range fossilMethodsTests {
+		l.Reset()
+		fed := true
+		for j := 0; j < len(tt.input); j++ {
+			fed = l.Feed(tt.input[j])
+		}
+
+		value, found := l.Value()
+		e := fed != tt.fed ||
+			value != tt.value ||
+			found != tt.found ||
+			found != l.Found()
+
+		if e {
+			t.Errorf(
+				testFossilMethodsError,
+				i,
+				tt.input,
+				fed,
+				value,
+				found,
+				tt.fed,
+				tt.value,
+				tt.found,
+			)
+		}
+	}
+}
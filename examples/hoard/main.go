@@ -7,6 +7,7 @@ import (
 	"runtime"
 
 	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/best"
 	"github.com/alex-ilchukov/radixt/compact/strg"
 	"github.com/alex-ilchukov/radixt/compact/structg"
 	"github.com/alex-ilchukov/radixt/evident"
@@ -94,6 +95,12 @@ func processGeneric(name string, t radixt.Tree) {
 		factory func(radixt.Tree) (radixt.Tree, error)
 		err     error
 	}{
+		{
+			name: "strg.New[strg.N2]",
+			factory: func(t radixt.Tree) (radixt.Tree, error) {
+				return strg.New[strg.N2](t)
+			},
+		},
 		{
 			name: "strg.New[strg.N3]",
 			factory: func(t radixt.Tree) (radixt.Tree, error) {
@@ -135,6 +142,12 @@ func processGeneric(name string, t radixt.Tree) {
 		printHoard(h)
 	}
 
+	r, err := best.Pick(t, best.Options{})
+	if err == nil {
+		fmt.Printf("\tThe best pick is %s!\n", r.Impl)
+		printHoard(r.Tree.(radixt.Hoarder))
+	}
+
 	errs := 0
 	for _, f := range factories {
 		if f.err != nil {
@@ -0,0 +1,5 @@
+// Package headers is an example of compactified radix tree of HTTP request
+// headers, declared as Go constant.
+package headers
+
+//go:generate go run ../../../cmd/compactconst -in headers.txt -name Headers
@@ -0,0 +1,115 @@
+// Code generated by github.com/alex-ilchukov/radixt/compact/literal. DO NOT EDIT.
+
+package headers
+
+import "github.com/alex-ilchukov/radixt/compact/str4"
+
+// Headers is compactified radix tree with str4.Tree implementation.
+const Headers str4.Tree = "\x17\x11\x1a\x0c\x1b\x07\x1b\x19R\x80\x00acdef" +
+	"himoprstuvwx-cua" +
+	"oanorotmnruaeoae" +
+	"erpsacfhruwpsnom" +
+	"naoacin-osfhp-e-" +
+	"hmntcdelelmt\x00\x00\x10\x01" +
+	"\x00\x008\x00\x00\x00)\x00\x00\x80)\x00\x19%\x00\x0a" +
+	"\x00\x80)\x00\x00\x00*\x00\xd8\x80J\x04v8\x00\x16" +
+	"\x14;\x00\x0a\x0e\x00;\x02\x00\x00,\x00\xc7r\x00\x10" +
+	"\x00\x00,\x00\x00\x80,\x00AQ\x00\x04\xedR\x00\x0c" +
+	"\x03\x00|\x02\xea\x02\x00\x04C\x80.\x04k\x12\x00\x16" +
+	"\x81\x14\x00\x16\x00\x00.\x00\xe7\"\x00\x04\x00X\x00\x02" +
+	"\xbf&\x00\x0e\x08\x00-\x02\"*\x00\x04^,\x00\x18" +
+	"\x00\x80,\x00\xac2\x00\x12\x1e5\x00\x0866\x00\x1e" +
+	"*=\x00\x06F>\x00\x069\x81*\x06\x1fC\x00\x06" +
+	"\x0aE\x00\x0a\x00F\x00\x00V\x80)\x02\x0fO\x1a\x0a" +
+	"\xcfL\x00\x10\x97d\x00\x16\x00\x00)\x00\xbe\x809\x12" +
+	"\x00b\x00&\x1e\x00*\x0c-k\x00\x06\xa2f\x00\x14" +
+	"\x00\x84\x19\x02\x13\x80)$\x00\x00*\x000!\x00\x06" +
+	"\x00(\x00\x00\x8c`\x00\x16\xb2.\x00\x0690\x00\x18" +
+	"j@\x00\x18\xb5h\x00\x123I\x00\x06EJ\x00\x1a" +
+	"%T\x00\"Rp\x00\x18\xd7l\x00\x10mZ\x00\x04" +
+	"6]\x00\x06&_\x00\x08\x10n\x00\x04\xf3V\x00\x0c" +
+	"\x00\x00B\x00\xf9\x10\x00\x0c\x05\x0e\x00\x0a\xb8\x16\x00\x0c" +
+	"\"\x01B\x08\xff\x06\x00\x0c\xe6\x08\x00\x0eK\x0a\x00\x0e" +
+	"\xdf\x0c\x00\x0eK\x18\x00\x0e\x05\x1b\x00\x0a?\x1d\x00\x04" +
+	"<\x1f\x00\x06ttp-method-o" +
+	"verrides-control" +
+	"-request-insecur" +
+	"e-requestsnmodif" +
+	"ied-sincesfer-en" +
+	"codingrrelation-" +
+	"idtp2-settingsut" +
+	"horizationax-for" +
+	"wardsche-control" +
+	"t-end-httpstt-de" +
+	"viceidap-profile" +
+	"one-matchonnecti" +
+	"onorwarded-ave-d" +
+	"ataer-agentrf-to" +
+	"kenanguageatetim" +
+	"earningd-withead" +
+	"ersharsetengthfe" +
+	"rergraderiginxpe" +
+	"ctangeent-rotogm" +
+	"aidhkielerostxy-" +
+	"yped5ia"
+
+// Values of keys in Headers tree.
+const (
+	HeadersAIm                         = 0  // "a-im"
+	HeadersAccept                      = 1  // "accept"
+	HeadersAcceptCharset               = 2  // "accept-charset"
+	HeadersAcceptDatetime              = 3  // "accept-datetime"
+	HeadersAcceptEncoding              = 4  // "accept-encoding"
+	HeadersAcceptLanguage              = 5  // "accept-language"
+	HeadersAccessControlRequestHeaders = 7  // "access-control-request-headers"
+	HeadersAccessControlRequestMethod  = 6  // "access-control-request-method"
+	HeadersAuthorization               = 8  // "authorization"
+	HeadersCacheControl                = 9  // "cache-control"
+	HeadersConnection                  = 10 // "connection"
+	HeadersContentEncoding             = 11 // "content-encoding"
+	HeadersContentLength               = 12 // "content-length"
+	HeadersContentMd5                  = 13 // "content-md5"
+	HeadersContentType                 = 14 // "content-type"
+	HeadersCookie                      = 15 // "cookie"
+	HeadersDate                        = 16 // "date"
+	HeadersDnt                         = 43 // "dnt"
+	HeadersExpect                      = 17 // "expect"
+	HeadersForwarded                   = 18 // "forwarded"
+	HeadersFrom                        = 19 // "from"
+	HeadersFrontEndHttps               = 47 // "front-end-https"
+	HeadersHost                        = 20 // "host"
+	HeadersHttp2Settings               = 21 // "http2-settings"
+	HeadersIfMatch                     = 22 // "if-match"
+	HeadersIfModifiedSince             = 23 // "if-modified-since"
+	HeadersIfNoneMatch                 = 24 // "if-none-match"
+	HeadersIfRange                     = 25 // "if-range"
+	HeadersIfUnmodifiedSince           = 26 // "if-unmodified-since"
+	HeadersMaxForwards                 = 27 // "max-forwards"
+	HeadersOrigin                      = 28 // "origin"
+	HeadersPragma                      = 29 // "pragma"
+	HeadersPrefer                      = 30 // "prefer"
+	HeadersProxyAuthorization          = 31 // "proxy-authorization"
+	HeadersProxyConnection             = 51 // "proxy-connection"
+	HeadersRange                       = 32 // "range"
+	HeadersReferer                     = 33 // "referer"
+	HeadersSaveData                    = 56 // "save-data"
+	HeadersTe                          = 34 // "te"
+	HeadersTrailer                     = 35 // "trailer"
+	HeadersTransferEncoding            = 36 // "transfer-encoding"
+	HeadersUpgrade                     = 38 // "upgrade"
+	HeadersUpgradeInsecureRequests     = 41 // "upgrade-insecure-requests"
+	HeadersUserAgent                   = 37 // "user-agent"
+	HeadersVia                         = 39 // "via"
+	HeadersWarning                     = 40 // "warning"
+	HeadersXAttDeviceid                = 49 // "x-att-deviceid"
+	HeadersXCorrelationId              = 55 // "x-correlation-id"
+	HeadersXCsrfToken                  = 53 // "x-csrf-token"
+	HeadersXForwardedFor               = 44 // "x-forwarded-for"
+	HeadersXForwardedHost              = 45 // "x-forwarded-host"
+	HeadersXForwardedProto             = 46 // "x-forwarded-proto"
+	HeadersXHttpMethodOverride         = 48 // "x-http-method-override"
+	HeadersXRequestId                  = 54 // "x-request-id"
+	HeadersXRequestedWith              = 42 // "x-requested-with"
+	HeadersXUidh                       = 52 // "x-uidh"
+	HeadersXWapProfile                 = 50 // "x-wap-profile"
+)
@@ -0,0 +1,36 @@
+package headers
+
+import (
+	"bufio"
+	"os"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+const testHeadersError = "Headers Test: got that Headers is\n\n%v\n\nwhich " +
+	"is not equal to\n\n%v\n\n(but should be equal)"
+
+func TestHeaders(t *testing.T) {
+	file, err := os.Open("headers.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	e := evident.New(sapling.New(lines...))
+	if !e.Eq(Headers) {
+		t.Errorf(testHeadersError, evident.New(Headers), e)
+	}
+
+	if HeadersContentLength != 12 || HeadersTe != 34 {
+		t.Errorf("Headers Test: wrong values of key constants")
+	}
+}
@@ -0,0 +1,17 @@
+// Package fold provides folding lookups in radix trees, that is, lookups,
+// which consider some bytes as equivalent. For example, HTTP header names are
+// case-insensitive, so "Content-Type" and "content-type" should lead to the
+// same key.
+//
+// Equivalence of bytes is set by [Table], which maps every byte to canonical
+// representative of its class. The package provides [ASCII] table for case
+// folding of ASCII letters, and allows to unite custom classes of bytes, for
+// example, to treat '-' and '_' as equal.
+//
+// The folding lookup works in two steps. First, a tree with keys, folded
+// accordingly to the table, is built with [New], which also detects keys,
+// colliding under the folding. Second, lookup [L] folds every fed byte with
+// the same table, so the lookup works with every tree implementation, which
+// is created from the folded tree, including implementations of
+// [lookup.Switcher].
+package fold
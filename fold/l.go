@@ -0,0 +1,33 @@
+package fold
+
+import (
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/lookup"
+)
+
+// L contains information on state of the folding lookup process. It has all
+// the methods of [lookup.L], but every byte, fed to it, is folded accordingly
+// to its table first.
+type L struct {
+	*lookup.L
+	f *Table
+}
+
+// NewLookup creates and initializes new folding lookup state accordingly to
+// the provided radix tree t and table f, and returns a pointer to the state.
+// The tree is supposed to have keys, folded accordingly to the table (see
+// [New]). Nil values of t are supported and interpreted as empty tree, and
+// nil values of f are interpreted as [Identity] table.
+func NewLookup(t radixt.Tree, f *Table) *L {
+	if f == nil {
+		f = &Identity
+	}
+
+	return &L{L: lookup.New(t), f: f}
+}
+
+// Feed takes byte b, folds it, and returns if the folded byte is found in
+// radix tree accordingly to the state or not.
+func (l *L) Feed(b byte) bool {
+	return l.L.Feed(l.f[b])
+}
@@ -0,0 +1,131 @@
+package fold_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/fold"
+	"github.com/alex-ilchukov/radixt/lookup"
+)
+
+func mustFold(t radixt.Tree, f *fold.Table) radixt.Tree {
+	result, err := fold.New(t, f)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+var (
+	folded       = mustFold(headers, &fold.ASCII)
+	foldedDashes = strg.MustCreate[strg.N3](mustFold(headers, &dashes))
+)
+
+var lTests = []struct {
+	tree  radixt.Tree
+	table *fold.Table
+	input string
+	v     uint
+	found bool
+}{
+	{tree: nil, table: &fold.ASCII, input: "", v: 0, found: false},
+	{tree: nil, table: nil, input: "x", v: 0, found: false},
+	{tree: folded, table: nil, input: "CONTENT-TYPE", v: 0, found: false},
+	{tree: folded, table: nil, input: "x-real-ip", v: 2, found: true},
+	{
+		tree:  folded,
+		table: &fold.ASCII,
+		input: "Content",
+		v:     0,
+		found: false,
+	},
+	{
+		tree:  folded,
+		table: &fold.ASCII,
+		input: "CONTENT-TYPE",
+		v:     0,
+		found: true,
+	},
+	{
+		tree:  folded,
+		table: &fold.ASCII,
+		input: "content-Length",
+		v:     1,
+		found: true,
+	},
+	{
+		tree:  folded,
+		table: &fold.ASCII,
+		input: "x-REAL-ip",
+		v:     2,
+		found: true,
+	},
+	{
+		tree:  folded,
+		table: &fold.ASCII,
+		input: "x_real_ip",
+		v:     0,
+		found: false,
+	},
+	{
+		tree:  foldedDashes,
+		table: &dashes,
+		input: "x_REAL-ip",
+		v:     2,
+		found: true,
+	},
+	{
+		tree:  foldedDashes,
+		table: &dashes,
+		input: "Content_Type",
+		v:     0,
+		found: true,
+	},
+	{
+		tree:  foldedDashes,
+		table: &dashes,
+		input: "Content_Types",
+		v:     0,
+		found: false,
+	},
+}
+
+const testLError = "L Test %d: for input %q got %d and %t " +
+	"(should be %d and %t)"
+
+func TestL(t *testing.T) {
+	for i, tt := range lTests {
+		l := fold.NewLookup(tt.tree, tt.table)
+		for j := 0; j < len(tt.input); j++ {
+			l.Feed(tt.input[j])
+		}
+
+		v, found := l.Value()
+		if v != tt.v || found != tt.found {
+			t.Errorf(
+				testLError,
+				i,
+				tt.input,
+				v,
+				found,
+				tt.v,
+				tt.found,
+			)
+		}
+	}
+}
+
+const testLReadUntilError = "L ReadUntil Test: got %d, %t, %d and %v " +
+	"(should be 1, true, 15 and nil)"
+
+func TestLReadUntil(t *testing.T) {
+	r := strings.NewReader("Content-LENGTH: 12")
+	l := fold.NewLookup(folded, &fold.ASCII)
+	v, found, n, err := lookup.ReadUntil(l, r, ':', 64)
+	if v != 1 || !found || n != 15 || err != nil {
+		t.Errorf(testLReadUntilError, v, found, n, err)
+	}
+}
@@ -0,0 +1,57 @@
+package fold
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+// ErrorTable is returned by [New], if the provided table is not proper (see
+// [Table.Proper]).
+var ErrorTable = errors.New("fold table is not idempotent")
+
+// ErrorCollision is returned by [New], if two keys of the provided tree are
+// equal under the folding.
+var ErrorCollision = errors.New("keys collide under the folding")
+
+// New creates a new sapling tree with all the keys of the provided tree t,
+// folded accordingly to table f, with the same values, and returns a pointer
+// on the tree with nil error. Nil values of t are supported and interpreted
+// as empty tree. It returns nil tree with [ErrorTable], if the table is not
+// proper, or with error, wrapping [ErrorCollision] and naming the keys, if two
+// keys of t become equal after the folding.
+func New(t radixt.Tree, f *Table) (*sapling.Tree, error) {
+	if !f.Proper() {
+		return nil, ErrorTable
+	}
+
+	var err error
+	result := sapling.New()
+	originals := make(map[string]string)
+	keys.Each(t, func(key []byte, v uint) bool {
+		original := string(key)
+		folded := f.Fold(original)
+		if other, has := originals[folded]; has {
+			err = fmt.Errorf(
+				"%w: %q and %q",
+				ErrorCollision,
+				other,
+				original,
+			)
+			return true
+		}
+
+		originals[folded] = original
+		result.Grow(folded, v)
+		return false
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
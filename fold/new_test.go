@@ -0,0 +1,77 @@
+package fold_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/fold"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var headers = sapling.New("Content-Type", "Content-Length", "X-Real-IP")
+
+var newTests = []struct {
+	tree   radixt.Tree
+	table  *fold.Table
+	result radixt.Tree
+	err    error
+}{
+	{tree: nil, table: &fold.ASCII, result: sapling.New(), err: nil},
+	{
+		tree:  headers,
+		table: &fold.ASCII,
+		result: sapling.New(
+			"content-type",
+			"content-length",
+			"x-real-ip",
+		),
+		err: nil,
+	},
+	{
+		tree:  headers,
+		table: &dashes,
+		result: sapling.New(
+			"content_type",
+			"content_length",
+			"x_real_ip",
+		),
+		err: nil,
+	},
+	{
+		tree:   sapling.New("Accept", "accept"),
+		table:  &fold.ASCII,
+		result: nil,
+		err:    fold.ErrorCollision,
+	},
+	{
+		tree:   headers,
+		table:  &fold.Table{1},
+		result: nil,
+		err:    fold.ErrorTable,
+	},
+}
+
+const testNewError = "New Test %d: got %v and error %v " +
+	"(should be %v and %v)"
+
+func TestNew(t *testing.T) {
+	for i, tt := range newTests {
+		result, err := fold.New(tt.tree, tt.table)
+		e := !errors.Is(err, tt.err) ||
+			(tt.result == nil) != (result == nil) ||
+			(result != nil && !evident.New(result).Eq(tt.result))
+
+		if e {
+			t.Errorf(
+				testNewError,
+				i,
+				evident.New(result),
+				err,
+				evident.New(tt.result),
+				tt.err,
+			)
+		}
+	}
+}
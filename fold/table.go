@@ -0,0 +1,81 @@
+package fold
+
+// Table maps every byte to canonical representative of its class of
+// equivalent bytes. Proper table is idempotent, that is, canonical
+// representatives are mapped to themselves.
+type Table [256]byte
+
+// Identity is the table, where every byte is the only member of its class.
+var Identity = identity()
+
+// ASCII is the table for case folding of ASCII letters: every upper-case
+// letter is mapped to its lower-case counterpart.
+var ASCII = Identity.With(
+	"Aa", "Bb", "Cc", "Dd", "Ee", "Ff", "Gg", "Hh", "Ii", "Jj", "Kk", "Ll",
+	"Mm", "Nn", "Oo", "Pp", "Qq", "Rr", "Ss", "Tt", "Uu", "Vv", "Ww", "Xx",
+	"Yy", "Zz",
+)
+
+func identity() (f Table) {
+	for i := range f {
+		f[i] = byte(i)
+	}
+
+	return
+}
+
+// With returns copy of table f, where all bytes of every provided class (and
+// all bytes, which are equivalent to them accordingly to f) are united into
+// one class. The canonical representative of the united class is the
+// greatest one among canonical representatives of the classes united. For
+// example, ASCII.With("-_") folds ASCII letters and treats '-' and '_' as
+// equal.
+func (f Table) With(classes ...string) Table {
+	for _, class := range classes {
+		if class == "" {
+			continue
+		}
+
+		c := f[class[0]]
+		for i := 1; i < len(class); i++ {
+			if c < f[class[i]] {
+				c = f[class[i]]
+			}
+		}
+
+		var united [256]bool
+		for i := 0; i < len(class); i++ {
+			united[f[class[i]]] = true
+		}
+
+		for i, b := range f {
+			if united[b] {
+				f[i] = c
+			}
+		}
+	}
+
+	return f
+}
+
+// Fold returns copy of string s, where every byte is replaced with its
+// canonical representative accordingly to table f.
+func (f *Table) Fold(s string) string {
+	b := make([]byte, len(s))
+	for i := 0; i < len(s); i++ {
+		b[i] = f[s[i]]
+	}
+
+	return string(b)
+}
+
+// Proper returns if table f is idempotent or not.
+func (f *Table) Proper() bool {
+	for _, b := range f {
+		if f[b] != b {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,103 @@
+package fold_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/fold"
+)
+
+var dashes = fold.ASCII.With("-_")
+
+var tableFoldTests = []struct {
+	table  *fold.Table
+	input  string
+	result string
+}{
+	{table: &fold.Identity, input: "Content-Type", result: "Content-Type"},
+	{table: &fold.ASCII, input: "", result: ""},
+	{table: &fold.ASCII, input: "Content-Type", result: "content-type"},
+	{table: &fold.ASCII, input: "X_Real-IP\xC0", result: "x_real-ip\xC0"},
+	{table: &dashes, input: "X-Real_IP", result: "x_real_ip"},
+}
+
+const testTableFoldError = "Table Fold Test %d: for input %q got %q " +
+	"(should be %q)"
+
+func TestTableFold(t *testing.T) {
+	for i, tt := range tableFoldTests {
+		result := tt.table.Fold(tt.input)
+		if result != tt.result {
+			t.Errorf(
+				testTableFoldError,
+				i,
+				tt.input,
+				result,
+				tt.result,
+			)
+		}
+	}
+}
+
+var tableProperTests = []struct {
+	table  fold.Table
+	result bool
+}{
+	{table: fold.Identity, result: true},
+	{table: fold.ASCII, result: true},
+	{table: dashes, result: true},
+	{table: fold.ASCII.With("aA", "bB", "ab"), result: true},
+	{table: fold.Identity.With("", "abc", "cde"), result: true},
+	{table: fold.Table{}, result: true},
+	{table: fold.Table{1}, result: false},
+}
+
+const testTableProperError = "Table Proper Test %d: got %t (should be %t)"
+
+func TestTableProper(t *testing.T) {
+	for i, tt := range tableProperTests {
+		result := tt.table.Proper()
+		if result != tt.result {
+			t.Errorf(testTableProperError, i, result, tt.result)
+		}
+	}
+}
+
+var tableWithTests = []struct {
+	table  fold.Table
+	input  string
+	result string
+}{
+	{
+		table:  fold.ASCII.With("aA", "bB", "ab"),
+		input:  "aAbB",
+		result: "bbbb",
+	},
+	{
+		table:  fold.Identity.With("abc", "cde"),
+		input:  "abcdef",
+		result: "eeeeef",
+	},
+	{
+		table:  fold.Identity.With("abc", "xy"),
+		input:  "abcxyz",
+		result: "cccyyz",
+	},
+}
+
+const testTableWithError = "Table With Test %d: for input %q got %q " +
+	"(should be %q)"
+
+func TestTableWith(t *testing.T) {
+	for i, tt := range tableWithTests {
+		result := tt.table.Fold(tt.input)
+		if result != tt.result {
+			t.Errorf(
+				testTableWithError,
+				i,
+				tt.input,
+				result,
+				tt.result,
+			)
+		}
+	}
+}
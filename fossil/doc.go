@@ -0,0 +1,14 @@
+// Package fossil provides _fossilization_ of radix trees, that is, generation
+// of Go source code, which hard-codes lookup process in the provided tree.
+//
+// The generated lookup type has the same Reset, Feed, Found, and Value
+// contract as [lookup.L] has, but its states, chunk comparisons and switches
+// over children are compiled Go statements instead of walks over data
+// structures. State of the lookup process is just index of current node and
+// amount of processed bytes of its chunk. Node indices of the generated code
+// are the renamed indices of [analysis.A], so children of any node go in
+// ascending order of first bytes of their chunks.
+//
+// The package also can generate a test file, which verifies the generated
+// lookup against keys, values and non-keys of the source tree.
+package fossil
@@ -0,0 +1,281 @@
+package fossil
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"strconv"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/analysis"
+)
+
+const generatedBy = "// Code generated by " +
+	"github.com/alex-ilchukov/radixt/fossil. DO NOT EDIT.\n\n"
+
+// Generate writes to w Go source code of lookup type with name o.Type in
+// package o.Package, which hard-codes lookup process in the provided tree t.
+// Nil values of t are supported and interpreted as empty tree. It returns
+// [ErrorPackage] or [ErrorType], if the options are invalid, or error of
+// writing to w.
+func Generate(w io.Writer, t radixt.Tree, o Options) error {
+	if err := o.check(); err != nil {
+		return err
+	}
+
+	g := newGenerator(t, o)
+	g.lookup()
+
+	return g.flush(w)
+}
+
+// GenerateTest writes to w Go source code of test for lookup type, generated
+// by [Generate] with the same tree t and options o. The test verifies, that
+// the generated lookup finds every key of the tree with proper value, and does
+// not find non-keys. It returns the same errors as [Generate] does.
+func GenerateTest(w io.Writer, t radixt.Tree, o Options) error {
+	if err := o.check(); err != nil {
+		return err
+	}
+
+	g := newGenerator(t, o)
+	g.test()
+
+	return g.flush(w)
+}
+
+type generator struct {
+	o     Options
+	nodes []analysis.N[analysis.Default]
+	b     bytes.Buffer
+}
+
+func newGenerator(t radixt.Tree, o Options) *generator {
+	a := analysis.Do[analysis.Default](t)
+	nodes := make([]analysis.N[analysis.Default], len(a.N))
+	for _, n := range a.N {
+		nodes[n.Index] = n
+	}
+
+	return &generator{o: o, nodes: nodes}
+}
+
+func (g *generator) p(format string, args ...any) {
+	fmt.Fprintf(&g.b, format, args...)
+}
+
+func (g *generator) flush(w io.Writer) error {
+	src, err := format.Source(g.b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+func (g *generator) lookup() {
+	ty := g.o.Type
+
+	g.p(generatedBy)
+	g.p("package %s\n\n", g.o.Package)
+
+	g.p("// %s contains information on state of the fossilized lookup "+
+		"process.\n", ty)
+	g.p("type %s struct {\n", ty)
+	g.p("n uint\n")
+	g.p("pos uint\n")
+	g.p("keep bool\n")
+	g.p("}\n\n")
+
+	g.p("// Reset resets the lookup state.\n")
+	g.p("func (l *%s) Reset() {\n", ty)
+	g.p("l.n = 0\n")
+	g.p("l.pos = 0\n")
+	g.p("l.keep = %t\n", len(g.nodes) > 0)
+	g.p("}\n\n")
+
+	g.p("// Feed takes byte b and returns if the byte is found in the " +
+		"fossilized\n// tree accordingly to the state or not.\n")
+	g.p("func (l *%s) Feed(b byte) bool {\n", ty)
+	g.p("if !l.keep {\nreturn false\n}\n\n")
+	if len(g.nodes) > 0 {
+		g.p("switch l.n {\n")
+		for i, n := range g.nodes {
+			g.feedCase(uint(i), n)
+		}
+		g.p("}\n\n")
+	}
+	g.p("return l.keep\n")
+	g.p("}\n\n")
+
+	g.p("// Found returns if the lookup state points to key with value in " +
+		"the fossilized\n// tree or not.\n")
+	g.p("func (l *%s) Found() bool {\n", ty)
+	g.p("_, found := l.Value()\n")
+	g.p("return found\n")
+	g.p("}\n\n")
+
+	g.p("// Value returns value v of the key with boolean true flag, if " +
+		"the lookup state\n// points to key with value in the " +
+		"fossilized tree, or default unsigned\n// integer with boolean " +
+		"false otherwise.\n")
+	g.p("func (l *%s) Value() (v uint, found bool) {\n", ty)
+	g.p("if !l.keep {\nreturn\n}\n\n")
+	if g.values() {
+		g.p("switch l.n {\n")
+		for i, n := range g.nodes {
+			if n.HasValue {
+				g.p("case %d:\n", i)
+				g.p("if l.pos == %d {\n", len(n.Chunk))
+				g.p("return %d, true\n", n.Value)
+				g.p("}\n")
+			}
+		}
+		g.p("}\n\n")
+	}
+	g.p("return\n")
+	g.p("}\n")
+}
+
+func (g *generator) values() bool {
+	for _, n := range g.nodes {
+		if n.HasValue {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *generator) feedCase(i uint, n analysis.N[analysis.Default]) {
+	g.p("case %d: // %s\n", i, strconv.Quote(n.Chunk))
+
+	l := len(n.Chunk)
+	if l > 0 {
+		g.p("if l.pos < %d {\n", l)
+		g.p("l.keep = b == %s[l.pos]\n", strconv.Quote(n.Chunk))
+		g.p("l.pos++\n")
+		g.p("break\n")
+		g.p("}\n\n")
+	}
+
+	if n.ChildrenHigh == 0 {
+		g.p("l.keep = false\n")
+		return
+	}
+
+	g.p("switch b {\n")
+	for c := n.ChildrenLow; c < n.ChildrenHigh; c++ {
+		g.p("case %s:\n", quoteByte(g.nodes[c].ChunkFirst))
+		g.p("l.n, l.pos = %d, 1\n", c)
+	}
+	g.p("default:\n")
+	g.p("l.keep = false\n")
+	g.p("}\n")
+}
+
+func (g *generator) test() {
+	ty := g.o.Type
+
+	g.p(generatedBy)
+	g.p("package %s\n\n", g.o.Package)
+	g.p("import \"testing\"\n\n")
+
+	g.p("var fossil%sTests = []struct {\n", ty)
+	g.p("input string\n")
+	g.p("fed bool\n")
+	g.p("value uint\n")
+	g.p("found bool\n")
+	g.p("}{\n")
+	g.testCases()
+	g.p("}\n\n")
+
+	g.p("const testFossil%sError = \"Fossil %s Test %%d: \" +\n", ty, ty)
+	g.p("\"for input %%q got %%t, %%d and %%t \" +\n")
+	g.p("\"(should be %%t, %%d and %%t)\"\n\n")
+
+	g.p("func TestFossil%s(t *testing.T) {\n", ty)
+	g.p("var l %s\n", ty)
+	g.p("for i, tt := range fossil%sTests {\n", ty)
+	g.p("l.Reset()\n")
+	g.p("fed := true\n")
+	g.p("for j := 0; j < len(tt.input); j++ {\n")
+	g.p("fed = l.Feed(tt.input[j])\n")
+	g.p("}\n\n")
+	g.p("value, found := l.Value()\n")
+	g.p("e := fed != tt.fed ||\n")
+	g.p("value != tt.value ||\n")
+	g.p("found != tt.found ||\n")
+	g.p("found != l.Found()\n\n")
+	g.p("if e {\n")
+	g.p("t.Errorf(\n")
+	g.p("testFossil%sError,\n", ty)
+	g.p("i,\ntt.input,\nfed,\nvalue,\nfound,\ntt.fed,\ntt.value," +
+		"\ntt.found,\n")
+	g.p(")\n")
+	g.p("}\n")
+	g.p("}\n")
+	g.p("}\n")
+}
+
+func (g *generator) testCases() {
+	if len(g.nodes) == 0 {
+		g.p("{input: \"\", fed: true, value: 0, found: false},\n")
+		g.p("{input: \"a\", fed: false, value: 0, found: false},\n")
+		return
+	}
+
+	keys := make([]string, len(g.nodes))
+	for i, n := range g.nodes {
+		key := n.Chunk
+		if i > 0 {
+			key = keys[n.Parent] + key
+		}
+		keys[i] = key
+
+		g.testCase(key, true, n.Value, n.HasValue)
+
+		if len(n.Chunk) > 1 {
+			g.testCase(key[:len(key)-1], true, 0, false)
+		}
+
+		if b, ok := g.absentByte(n); ok {
+			g.testCase(key+string([]byte{b}), false, 0, false)
+		}
+	}
+}
+
+func (g *generator) testCase(input string, fed bool, v uint, found bool) {
+	g.p(
+		"{input: %s, fed: %t, value: %d, found: %t},\n",
+		strconv.Quote(input),
+		fed,
+		v,
+		found,
+	)
+}
+
+func (g *generator) absentByte(n analysis.N[analysis.Default]) (byte, bool) {
+	var present [256]bool
+	for c := n.ChildrenLow; c < n.ChildrenHigh; c++ {
+		present[g.nodes[c].ChunkFirst] = true
+	}
+
+	for b := 0; b < len(present); b++ {
+		if !present[b] {
+			return byte(b), true
+		}
+	}
+
+	return 0, false
+}
+
+func quoteByte(b byte) string {
+	if b >= 0x20 && b < 0x7F {
+		return strconv.QuoteRune(rune(b))
+	}
+
+	return fmt.Sprintf("0x%02X", b)
+}
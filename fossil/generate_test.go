@@ -0,0 +1,132 @@
+package fossil_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/fossil"
+	"github.com/alex-ilchukov/radixt/null"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var (
+	empty = sapling.New()
+
+	atree = sapling.New(
+		"authority",
+		"authorization",
+		"author",
+		"authentication",
+		"auth",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	)
+
+	withBlank = sapling.New("", "a", "ab")
+
+	options = fossil.Options{Package: "headers", Type: "Lookup"}
+)
+
+type generate func(w *bytes.Buffer, t radixt.Tree, o fossil.Options) error
+
+func lookup(w *bytes.Buffer, t radixt.Tree, o fossil.Options) error {
+	return fossil.Generate(w, t, o)
+}
+
+func test(w *bytes.Buffer, t radixt.Tree, o fossil.Options) error {
+	return fossil.GenerateTest(w, t, o)
+}
+
+var generateTests = []struct {
+	g      generate
+	tree   radixt.Tree
+	golden string
+}{
+	{g: lookup, tree: nil, golden: "empty.golden"},
+	{g: lookup, tree: null.Tree, golden: "empty.golden"},
+	{g: lookup, tree: empty, golden: "empty.golden"},
+	{g: lookup, tree: atree, golden: "atree.golden"},
+	{g: lookup, tree: withBlank, golden: "blank.golden"},
+	{g: test, tree: empty, golden: "empty_test.golden"},
+	{g: test, tree: atree, golden: "atree_test.golden"},
+	{g: test, tree: withBlank, golden: "blank_test.golden"},
+}
+
+const testGenerateError = "Generate Test %d: got\n\n%s\n\nwhich is not " +
+	"equal to content of %s file"
+
+func TestGenerate(t *testing.T) {
+	for i, tt := range generateTests {
+		var b bytes.Buffer
+		if err := tt.g(&b, tt.tree, options); err != nil {
+			t.Fatalf("Generate Test %d: unexpected error %s", i, err)
+		}
+
+		path := filepath.Join("testdata", tt.golden)
+		if *update {
+			if err := os.WriteFile(path, b.Bytes(), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		golden, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(b.Bytes(), golden) {
+			t.Errorf(testGenerateError, i, b.String(), path)
+		}
+	}
+}
+
+var generateErrorTests = []struct {
+	g      generate
+	o      fossil.Options
+	result error
+}{
+	{g: lookup, o: fossil.Options{}, result: fossil.ErrorPackage},
+	{g: test, o: fossil.Options{}, result: fossil.ErrorPackage},
+	{
+		g:      lookup,
+		o:      fossil.Options{Package: "1a", Type: "Lookup"},
+		result: fossil.ErrorPackage,
+	},
+	{
+		g:      lookup,
+		o:      fossil.Options{Package: "headers"},
+		result: fossil.ErrorType,
+	},
+	{
+		g:      test,
+		o:      fossil.Options{Package: "headers", Type: "lookup"},
+		result: fossil.ErrorType,
+	},
+	{
+		g:      lookup,
+		o:      fossil.Options{Package: "headers", Type: "Look up"},
+		result: fossil.ErrorType,
+	},
+	{g: lookup, o: options, result: nil},
+	{g: test, o: options, result: nil},
+}
+
+const testGenerateErrorError = "Generate Error Test %d: got %v error " +
+	"(should be %v)"
+
+func TestGenerateError(t *testing.T) {
+	for i, tt := range generateErrorTests {
+		var b bytes.Buffer
+		result := tt.g(&b, atree, tt.o)
+		if result != tt.result {
+			t.Errorf(testGenerateErrorError, i, result, tt.result)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package fossil
+
+import (
+	"errors"
+	"go/token"
+)
+
+// ErrorPackage is returned by generation functions, if the provided name of
+// package is not a valid Go identifier.
+var ErrorPackage = errors.New("package name is not a valid identifier")
+
+// ErrorType is returned by generation functions, if the provided name of
+// lookup type is not a valid exported Go identifier.
+var ErrorType = errors.New("type name is not a valid exported identifier")
+
+// Options represents settings of the generated code.
+type Options struct {
+	// Package is name of package of the generated code.
+	Package string
+
+	// Type is name of the generated lookup type. It must be exported.
+	Type string
+}
+
+func (o Options) check() error {
+	switch {
+	case !token.IsIdentifier(o.Package):
+		return ErrorPackage
+
+	case !token.IsIdentifier(o.Type) || !token.IsExported(o.Type):
+		return ErrorType
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package radixt_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/compact/struct32"
+	"github.com/alex-ilchukov/radixt/compact/struct64"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/generic"
+	enum "github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/lookup"
+	"github.com/alex-ilchukov/radixt/sapling"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+type builder struct {
+	name  string
+	build func(t radixt.Tree) (radixt.Tree, error)
+}
+
+func infallible[T radixt.Tree](f func(radixt.Tree) T) func(radixt.Tree) (
+	radixt.Tree,
+	error,
+) {
+	return func(t radixt.Tree) (radixt.Tree, error) {
+		return f(t), nil
+	}
+}
+
+var builders = []builder{
+	{name: "evident", build: infallible(evident.New)},
+	{name: "generic", build: infallible(generic.New)},
+	{name: "str3", build: treetest.Builder(str3.New)},
+	{name: "str4", build: treetest.Builder(str4.New)},
+	{name: "strg.N2", build: treetest.Builder(strg.New[strg.N2])},
+	{name: "strg.N3", build: treetest.Builder(strg.New[strg.N3])},
+	{name: "strg.N4", build: treetest.Builder(strg.New[strg.N4])},
+	{name: "struct32", build: treetest.Builder(struct32.New)},
+	{name: "struct64", build: treetest.Builder(struct64.New)},
+	{
+		name:  "structg.uint32",
+		build: treetest.Builder(structg.New[uint32]),
+	},
+	{
+		name:  "structg.uint64",
+		build: treetest.Builder(structg.New[uint64]),
+	},
+	{name: "str3 parents", build: treetest.Builder(str3.NewWithParents)},
+	{name: "str4 parents", build: treetest.Builder(str4.NewWithParents)},
+	{
+		name:  "strg.N2 parents",
+		build: treetest.Builder(strg.NewWithParents[strg.N2]),
+	},
+	{
+		name:  "strg.N3 parents",
+		build: treetest.Builder(strg.NewWithParents[strg.N3]),
+	},
+	{
+		name:  "strg.N4 parents",
+		build: treetest.Builder(strg.NewWithParents[strg.N4]),
+	},
+	{
+		name:  "structg.uint32 parents",
+		build: treetest.Builder(structg.NewWithParents[uint32]),
+	},
+	{
+		name:  "structg.uint64 parents",
+		build: treetest.Builder(structg.NewWithParents[uint64]),
+	},
+}
+
+// encode represents key and value couples in form of fuzzing input: every
+// couple is 16-bit length of key, the key and varint of the value.
+func encode(sv ...sapling.SV) []byte {
+	var data []byte
+	for _, c := range sv {
+		data = binary.LittleEndian.AppendUint16(data, uint16(len(c.S)))
+		data = append(data, c.S...)
+		data = binary.AppendUvarint(data, uint64(c.V))
+	}
+
+	return data
+}
+
+// decode is reverse of encode, which stops on the first malformed couple.
+func decode(data []byte) (sv []sapling.SV) {
+	for len(data) >= 2 {
+		l := int(binary.LittleEndian.Uint16(data))
+		data = data[2:]
+		if l > len(data) {
+			return
+		}
+
+		s := string(data[:l])
+		data = data[l:]
+		v, n := binary.Uvarint(data)
+		if n <= 0 || v != uint64(uint(v)) {
+			return
+		}
+
+		data = data[n:]
+		sv = append(sv, sapling.SV{S: s, V: uint(v)})
+	}
+
+	return
+}
+
+func fuzzSeeds() [][]byte {
+	siblings := make([]sapling.SV, 256)
+	for i := range siblings {
+		siblings[i] = sapling.SV{S: string([]byte{byte(i)}), V: uint(i)}
+	}
+
+	long := strings.Repeat("0123456789abcdef", 512)
+
+	return [][]byte{
+		nil,
+		encode(sapling.SV{S: "", V: 0}),
+		encode(sapling.SV{S: "", V: 1}, sapling.SV{S: "a", V: 2}),
+		encode(
+			sapling.SV{S: "auth", V: 0},
+			sapling.SV{S: "author", V: 1},
+			sapling.SV{S: "authority", V: 2},
+			sapling.SV{S: "authorization", V: 3},
+			sapling.SV{S: "authentication", V: 4},
+		),
+		encode(sapling.SV{S: "key", V: 1}, sapling.SV{S: "key", V: 2}),
+		encode(siblings...),
+		encode(
+			sapling.SV{S: long, V: 0},
+			sapling.SV{S: long[:1000] + "!", V: 1},
+			sapling.SV{S: "\x00" + long, V: 2},
+		),
+		encode(
+			sapling.SV{S: "large", V: 1 << 31},
+			sapling.SV{S: "larger", V: 1<<32 - 1},
+		),
+	}
+}
+
+func FuzzImplementations(f *testing.F) {
+	for _, seed := range fuzzSeeds() {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		sv := decode(data)
+		oracle := make(map[string]uint, len(sv))
+		for _, c := range sv {
+			oracle[c.S] = c.V
+		}
+
+		original := sapling.NewFromSV(sv...)
+		checkAgainst(t, "sapling", original, original, oracle)
+
+		for _, b := range builders {
+			tree, err := b.build(original)
+			if err != nil {
+				if !overflow(err) {
+					t.Errorf("%s: error %v", b.name, err)
+				}
+
+				continue
+			}
+
+			checkAgainst(t, b.name, tree, original, oracle)
+			if err := radixt.Validate(tree); err != nil {
+				t.Errorf("%s: %v", b.name, err)
+			}
+		}
+	})
+}
+
+func overflow(err error) bool {
+	return errors.Is(err, compact.ErrorOverflow) ||
+		errors.Is(err, compact.ErrorNodesOverflow) ||
+		errors.Is(err, compact.ErrorChunksOverflow)
+}
+
+func checkAgainst(
+	t *testing.T,
+	name string,
+	tree, original radixt.Tree,
+	oracle map[string]uint,
+) {
+	// Lookups in evident trees take quadratic time, so they are skipped in
+	// favour of fuzzing speed: the trees are compared to the original
+	// anyway.
+	if _, slow := tree.(evident.Tree); !slow {
+		checkLookups(t, name, tree, oracle)
+	}
+
+	if !evident.New(tree).Eq(original) {
+		t.Errorf("%s: tree is not equal to original", name)
+	}
+
+	var enumerated []string
+	enum.Each(tree, func(key []byte, v uint) bool {
+		enumerated = append(enumerated, string(key))
+		if u, has := oracle[string(key)]; !has || u != v {
+			t.Errorf("%s: enumerated %q with %d", name, key, v)
+		}
+
+		return false
+	})
+
+	e := len(enumerated) != len(oracle) ||
+		!sort.SliceIsSorted(enumerated, func(i, j int) bool {
+			return enumerated[i] < enumerated[j]
+		})
+
+	if e {
+		t.Errorf(
+			"%s: enumerated %d keys (should be %d)",
+			name,
+			len(enumerated),
+			len(oracle),
+		)
+	}
+
+	if h, ok := tree.(radixt.Hoarder); ok {
+		amount, hint := h.Hoard()
+		e := hint > radixt.HoardAtLeast ||
+			(tree.Size() > 0 && amount == 0)
+
+		if e {
+			t.Errorf("%s: hoard got %d and %d", name, amount, hint)
+		}
+	}
+}
+
+func checkValue(
+	t *testing.T,
+	name string,
+	l *lookup.L,
+	key string,
+	oracle map[string]uint,
+) {
+	v, found := l.Value()
+	u, has := oracle[key]
+	if found != has || v != u {
+		t.Errorf(
+			"%s: lookup of %q got %d, %t (should be %d, %t)",
+			name,
+			key,
+			v,
+			found,
+			u,
+			has,
+		)
+	}
+}
+
+func checkLookups(
+	t *testing.T,
+	name string,
+	tree radixt.Tree,
+	oracle map[string]uint,
+) {
+	l := lookup.New(tree)
+	for key := range oracle {
+		l.Reset()
+		for i := 0; i <= len(key); i++ {
+			if i > 0 && !l.Feed(key[i-1]) {
+				t.Errorf("%s: no way to %q", name, key[:i])
+				break
+			}
+
+			checkValue(t, name, l, key[:i], oracle)
+		}
+
+		if l.Feed(0xA5) {
+			checkValue(t, name, l, key+"\xA5", oracle)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Package fuzzy provides approximate search of keys in radix trees: it finds
+// all the keys within the provided edit distance of a query, for example, for
+// "did you mean" suggestions. The search works with any implementation of
+// [radixt.Tree] interface.
+//
+// The search walks the tree byte by byte of the node chunks and maintains a
+// row of dynamic-programming matrix of edit distances between the current
+// prefix and prefixes of the query for every byte. Subtrees, where any key
+// would be too far from the query, are skipped. Both Levenshtein distance and
+// its variant with transpositions of adjacent bytes (optimal string alignment
+// distance, also known as restricted Damerau-Levenshtein distance) are
+// supported.
+package fuzzy
@@ -0,0 +1,175 @@
+package fuzzy
+
+import (
+	"sort"
+
+	"github.com/alex-ilchukov/radixt"
+)
+
+// Mode is a kind of edit distance.
+type Mode int
+
+const (
+	// Levenshtein mode counts insertions, deletions and substitutions of
+	// bytes.
+	Levenshtein Mode = iota
+
+	// Damerau mode additionally counts transpositions of two adjacent
+	// bytes as single edit (optimal string alignment distance).
+	Damerau
+)
+
+// Match represents a key with value in a tree with its edit distance to the
+// query.
+type Match struct {
+	Key      string
+	Value    uint
+	Distance int
+}
+
+// Search returns all the keys with values of the provided tree t, which are
+// within edit distance k of the provided query, with their distances. Kind of
+// the edit distance is set by mode m. The matches are sorted by ascending
+// order of distances and by byte-lexicographic order of keys with the same
+// distance. The function returns nil if t is nil or k is negative.
+func Search(t radixt.Tree, query string, k int, m Mode) []Match {
+	if t == nil || t.Size() == 0 || k < 0 {
+		return nil
+	}
+
+	w := len(query) + 1
+	s := searcher{
+		t:       t,
+		query:   query,
+		k:       k,
+		damerau: m == Damerau,
+		rows:    make([]int, w, w*(len(query)+k+1)),
+		mins:    []int{0},
+	}
+
+	for j := range s.rows {
+		s.rows[j] = j
+	}
+
+	s.walk(0)
+
+	result := s.result
+	sort.Slice(result, func(i, j int) bool {
+		a := result[i]
+		b := result[j]
+		if a.Distance != b.Distance {
+			return a.Distance < b.Distance
+		}
+
+		return a.Key < b.Key
+	})
+
+	return result
+}
+
+// searcher contains state of the search: the current key and rows of edit
+// distances between the key prefixes and the query prefixes. Row i contains
+// the distances for key prefix of length i, and its element j is distance
+// from the key prefix to the query prefix of length j. Minimal values of the
+// rows are kept separately.
+type searcher struct {
+	t       radixt.Tree
+	query   string
+	k       int
+	damerau bool
+	key     []byte
+	rows    []int
+	mins    []int
+	result  []Match
+}
+
+func (s *searcher) walk(n uint) {
+	chunk := s.t.Chunk(n)
+	l := len(s.key)
+	defer s.pop(l)
+
+	for i := 0; i < len(chunk); i++ {
+		if !s.push(chunk[i]) {
+			return
+		}
+	}
+
+	distance := s.rows[len(s.rows)-1]
+	if v, has := s.t.Value(n); has && distance <= s.k {
+		s.result = append(s.result, Match{
+			Key:      string(s.key),
+			Value:    v,
+			Distance: distance,
+		})
+	}
+
+	s.t.EachChild(n, func(c uint) bool {
+		s.walk(c)
+		return false
+	})
+}
+
+// push appends byte b to the current key with new row of the distances and
+// returns if keys with the new prefix can still be close enough to the query.
+func (s *searcher) push(b byte) bool {
+	query := s.query
+	w := len(query) + 1
+	s.key = append(s.key, b)
+	i := len(s.key)
+	prev := s.rows[(i-1)*w:]
+	s.rows = append(s.rows, i)
+	row := s.rows[i*w:]
+
+	least := i
+	for j := 1; j < w; j++ {
+		cost := 1
+		if query[j-1] == b {
+			cost = 0
+		}
+
+		d := prev[j-1] + cost
+		if e := prev[j] + 1; e < d {
+			d = e
+		}
+
+		if e := row[j-1] + 1; e < d {
+			d = e
+		}
+
+		transposed := s.damerau &&
+			i > 1 &&
+			j > 1 &&
+			query[j-1] == s.key[i-2] &&
+			query[j-2] == b
+
+		if transposed {
+			if e := s.rows[(i-2)*w+j-2] + 1; e < d {
+				d = e
+			}
+		}
+
+		s.rows = append(s.rows, d)
+		row = s.rows[i*w:]
+		if d < least {
+			least = d
+		}
+	}
+
+	s.mins = append(s.mins, least)
+
+	// Every distance of the next row is based on a distance of the current
+	// row or, in the case of transposition, on a distance of the previous
+	// row plus one.
+	if least <= s.k {
+		return true
+	}
+
+	return s.damerau && s.mins[i-1] < s.k
+}
+
+// pop truncates the current key and the rows to key length l.
+func (s *searcher) pop(l int) {
+	s.key = s.key[:l]
+	s.rows = s.rows[:(l+1)*(len(s.query)+1)]
+	s.mins = s.mins[:l+1]
+}
@@ -0,0 +1,260 @@
+package fuzzy_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/fuzzy"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var (
+	headers = []string{
+		"accept",
+		"accept-encoding",
+		"accept-language",
+		"authority",
+		"authorization",
+		"author",
+		"content-length",
+		"content-type",
+		"host",
+	}
+
+	atree = sapling.New(headers...)
+	ctree = str4.MustCreate(atree)
+	gtree = generic.New(sapling.New("", "a", "ab", "ba"))
+)
+
+var searchTests = []struct {
+	t      radixt.Tree
+	query  string
+	k      int
+	mode   fuzzy.Mode
+	result []fuzzy.Match
+}{
+	{t: nil, query: "host", k: 1, mode: fuzzy.Levenshtein, result: nil},
+	{
+		t:      sapling.New(),
+		query:  "host",
+		k:      1,
+		mode:   fuzzy.Levenshtein,
+		result: nil,
+	},
+	{t: atree, query: "host", k: -1, mode: fuzzy.Levenshtein, result: nil},
+	{
+		t:      atree,
+		query:  "host",
+		k:      0,
+		mode:   fuzzy.Levenshtein,
+		result: []fuzzy.Match{{Key: "host", Value: 8, Distance: 0}},
+	},
+	{
+		t:      ctree,
+		query:  "hots",
+		k:      1,
+		mode:   fuzzy.Levenshtein,
+		result: nil,
+	},
+	{
+		t:      ctree,
+		query:  "hots",
+		k:      1,
+		mode:   fuzzy.Damerau,
+		result: []fuzzy.Match{{Key: "host", Value: 8, Distance: 1}},
+	},
+	{
+		t:      ctree,
+		query:  "hots",
+		k:      2,
+		mode:   fuzzy.Levenshtein,
+		result: []fuzzy.Match{{Key: "host", Value: 8, Distance: 2}},
+	},
+	{
+		t:     atree,
+		query: "content-lenght",
+		k:     2,
+		mode:  fuzzy.Levenshtein,
+		result: []fuzzy.Match{
+			{Key: "content-length", Value: 6, Distance: 2},
+		},
+	},
+	{
+		t:     atree,
+		query: "authorty",
+		k:     2,
+		mode:  fuzzy.Levenshtein,
+		result: []fuzzy.Match{
+			{Key: "authority", Value: 3, Distance: 1},
+			{Key: "author", Value: 5, Distance: 2},
+		},
+	},
+	{
+		t:     ctree,
+		query: "acept",
+		k:     1,
+		mode:  fuzzy.Damerau,
+		result: []fuzzy.Match{
+			{Key: "accept", Value: 0, Distance: 1},
+		},
+	},
+	{
+		t:     gtree,
+		query: "",
+		k:     1,
+		mode:  fuzzy.Levenshtein,
+		result: []fuzzy.Match{
+			{Key: "", Value: 0, Distance: 0},
+			{Key: "a", Value: 1, Distance: 1},
+		},
+	},
+	{
+		t:     gtree,
+		query: "ab",
+		k:     2,
+		mode:  fuzzy.Damerau,
+		result: []fuzzy.Match{
+			{Key: "ab", Value: 2, Distance: 0},
+			{Key: "a", Value: 1, Distance: 1},
+			{Key: "ba", Value: 3, Distance: 1},
+			{Key: "", Value: 0, Distance: 2},
+		},
+	},
+}
+
+const testSearchError = "Search Test %d: got %v for search of %q within " +
+	"distance %d (should be %v)"
+
+func TestSearch(t *testing.T) {
+	for i, tt := range searchTests {
+		result := fuzzy.Search(tt.t, tt.query, tt.k, tt.mode)
+		if !reflect.DeepEqual(result, tt.result) {
+			t.Errorf(
+				testSearchError,
+				i,
+				result,
+				tt.query,
+				tt.k,
+				tt.result,
+			)
+		}
+	}
+}
+
+// distance is straightforward implementation of optimal string alignment
+// distance, which turns into Levenshtein distance without transpositions.
+func distance(a, b string, transpositions bool) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = d[i-1][j-1] + cost
+			if d[i-1][j]+1 < d[i][j] {
+				d[i][j] = d[i-1][j] + 1
+			}
+
+			if d[i][j-1]+1 < d[i][j] {
+				d[i][j] = d[i][j-1] + 1
+			}
+
+			transposed := transpositions &&
+				i > 1 &&
+				j > 1 &&
+				a[i-1] == b[j-2] &&
+				a[i-2] == b[j-1]
+
+			if transposed && d[i-2][j-2]+1 < d[i][j] {
+				d[i][j] = d[i-2][j-2] + 1
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
+
+func random(r *rand.Rand) string {
+	b := make([]byte, r.Intn(7))
+	for i := range b {
+		b[i] = "abc"[r.Intn(3)]
+	}
+
+	return string(b)
+}
+
+const testSearchRandomError = "Search Random Test %d: got %v for search of " +
+	"%q within distance %d in mode %d over %q (should be %v)"
+
+func TestSearchRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		strings := make([]string, r.Intn(30))
+		for j := range strings {
+			strings[j] = random(r)
+		}
+
+		tree := sapling.New(strings...)
+		query := random(r)
+		k := r.Intn(4)
+		mode := fuzzy.Mode(r.Intn(2))
+
+		var expected []fuzzy.Match
+		seen := make(map[string]bool)
+		for j := len(strings) - 1; j >= 0; j-- {
+			s := strings[j]
+			d := distance(s, query, mode == fuzzy.Damerau)
+			if seen[s] || d > k {
+				seen[s] = true
+				continue
+			}
+
+			seen[s] = true
+			expected = append(expected, fuzzy.Match{
+				Key:      s,
+				Value:    uint(j),
+				Distance: d,
+			})
+		}
+
+		sort.Slice(expected, func(i, j int) bool {
+			a := expected[i]
+			b := expected[j]
+			if a.Distance != b.Distance {
+				return a.Distance < b.Distance
+			}
+
+			return a.Key < b.Key
+		})
+
+		result := fuzzy.Search(tree, query, k, mode)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf(
+				testSearchRandomError,
+				i,
+				result,
+				query,
+				k,
+				mode,
+				strings,
+				expected,
+			)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package generic_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+func TestConformance(t *testing.T) {
+	treetest.Conformance(t, func(t radixt.Tree) (radixt.Tree, error) {
+		return generic.New(t), nil
+	})
+}
@@ -9,4 +9,7 @@
 // As the tree struct is not exported outside, the implementation assumes that
 // instance is never nil. Also, it is totally static and safe to use by
 // multiple goroutines concurrently.
+//
+// The tree can be serialized into binary form of package serial with its
+// MarshalBinary method and loaded back, ready to use, with [Decode].
 package generic
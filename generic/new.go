@@ -15,7 +15,7 @@ func New(t radixt.Tree) *tree {
 			chunkFirst: n.ChunkFirst,
 			chunkEmpty: n.ChunkEmpty,
 			hasValue:   n.HasValue,
-			cAmount:    byte(n.ChildrenHigh - n.ChildrenLow),
+			cAmount:    uint16(n.ChildrenHigh - n.ChildrenLow),
 			cFirst:     n.ChildrenLow,
 			chunkLow:   n.ChunkPos,
 			chunkHigh:  n.ChunkPos + uint(len(n.Chunk)),
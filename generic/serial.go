@@ -0,0 +1,141 @@
+package generic
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/alex-ilchukov/radixt/serial"
+)
+
+// width is amount of bytes in serialized node: first byte of chunk, flags,
+// 16-bit amount of children and four 64-bit fields.
+const width = 4 + 4*8
+
+const (
+	flagChunkEmpty = 1 << iota
+	flagHasValue
+)
+
+// MarshalBinary returns the tree in binary form of package serial.
+func (t *tree) MarshalBinary() ([]byte, error) {
+	f := serial.Frame{
+		Kind:   serial.KindGeneric,
+		Width:  width,
+		Chunks: t.c,
+		Nodes:  make([]byte, 0, len(t.nodes)*width),
+	}
+
+	for _, n := range t.nodes {
+		var flags byte
+		if n.chunkEmpty {
+			flags |= flagChunkEmpty
+		}
+
+		if n.hasValue {
+			flags |= flagHasValue
+		}
+
+		f.Nodes = append(f.Nodes, n.chunkFirst, flags)
+		f.Nodes = binary.LittleEndian.AppendUint16(f.Nodes, n.cAmount)
+		fields := [...]uint{n.cFirst, n.chunkLow, n.chunkHigh, n.value}
+		for _, u := range fields {
+			f.Nodes = appendUint64(f.Nodes, u)
+		}
+	}
+
+	return f.Encode()
+}
+
+// UnmarshalBinary replaces the tree with the one, decoded from binary form
+// data (see [Decode]). The tree is left intact in case of an error.
+func (t *tree) UnmarshalBinary(data []byte) error {
+	result, err := Decode(data)
+	if err == nil {
+		*t = *result
+	}
+
+	return err
+}
+
+// Decode takes binary form data of a generic tree, produced by its
+// MarshalBinary method, and returns the decoded tree, ready to use, with nil
+// error. In case of an error, it returns nil for tree and the error, which is
+// one of the errors of package serial or wraps [serial.ErrorCorrupted].
+func Decode(data []byte) (*tree, error) {
+	f, err := serial.Decode(data, serial.KindGeneric, width)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Flags != 0 || f.Header != [8]byte{} || f.Firsts != "" {
+		return nil, serial.ErrorCorrupted
+	}
+
+	size := f.Size()
+	t := &tree{c: f.Chunks, nodes: make([]node, size)}
+	for i := uint(0); i < size; i++ {
+		n, reason := decodeNode(f.Node(i), uint(len(t.c)))
+		if reason == "" && n.chunkEmpty && i > 0 {
+			reason = "empty chunk of non-root"
+		}
+
+		if reason != "" {
+			return nil, serial.Corrupted(i, reason)
+		}
+
+		t.nodes[i] = n
+	}
+
+	err = serial.CheckChildren(size, t.childrenRange, t.first)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func appendUint64(b []byte, u uint) []byte {
+	return binary.LittleEndian.AppendUint64(b, uint64(u))
+}
+
+func decodeNode(b []byte, chunksLen uint) (n node, reason string) {
+	n.chunkFirst = b[0]
+	flags := b[1]
+	n.cAmount = binary.LittleEndian.Uint16(b[2:])
+	n.chunkEmpty = flags&flagChunkEmpty != 0
+	n.hasValue = flags&flagHasValue != 0
+
+	var u [4]uint
+	for i := range u {
+		v := binary.LittleEndian.Uint64(b[4+8*i:])
+		if v > math.MaxUint {
+			return n, "field overflows"
+		}
+
+		u[i] = uint(v)
+	}
+
+	n.cFirst, n.chunkLow, n.chunkHigh, n.value = u[0], u[1], u[2], u[3]
+
+	switch {
+	case flags&^(flagChunkEmpty|flagHasValue) != 0:
+		reason = "unknown flags"
+
+	case n.chunkLow > n.chunkHigh || n.chunkHigh > chunksLen:
+		reason = "invalid chunk range"
+
+	case n.chunkEmpty && (n.chunkFirst != 0 || n.chunkHigh > n.chunkLow):
+		reason = "invalid empty chunk"
+
+	case !n.hasValue && n.value != 0:
+		reason = "value without flag"
+
+	}
+
+	return
+}
+
+func (t *tree) first(n uint) (byte, bool) {
+	node := t.nodes[n]
+	return node.chunkFirst, !node.chunkEmpty
+}
@@ -9,7 +9,7 @@ type node struct {
 	chunkFirst byte
 	chunkEmpty bool
 	hasValue   bool
-	cAmount    byte
+	cAmount    uint16
 	cFirst     uint
 	chunkLow   uint
 	chunkHigh  uint
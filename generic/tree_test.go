@@ -194,6 +194,33 @@ var treeEachChildTests = []struct {
 const testTreeEachChildError = "Tree Each Child Test %d: got %s as result " +
 	"indices (should be %s)"
 
+// wideKeys returns all the one-byte keys, so the root has 256 children.
+func wideKeys() (keys []string) {
+	for b := 0; b < 256; b++ {
+		keys = append(keys, string([]byte{byte(b)}))
+	}
+
+	return
+}
+
+const testTreeEachChildWideError = "Tree Each Child Wide Test: got %d " +
+	"children of the root (should be %d)"
+
+// TestTreeEachChildWide verifies, that amount of children is not truncated
+// to a byte.
+func TestTreeEachChildWide(t *testing.T) {
+	tree := generic.New(sapling.New(wideKeys()...))
+	amount := 0
+	tree.EachChild(0, func(c uint) bool {
+		amount++
+		return false
+	})
+
+	if amount != 256 {
+		t.Errorf(testTreeEachChildWideError, amount, 256)
+	}
+}
+
 func TestTreeEachChild(t *testing.T) {
 	for i, tt := range treeEachChildTests {
 		indices := tt.f(tt.tree, tt.n)
@@ -521,3 +548,23 @@ func TestTreeSwitch(t *testing.T) {
 		}
 	}
 }
+
+const testTreeSwitchWideError = "Tree Switch Wide Test: got %d, '%s', and " +
+	"%t, trying to switch from the root by byte %d (should be child with " +
+	"empty rest)"
+
+// TestTreeSwitchWide verifies, that every child of node with more than 255
+// children is found by its first byte.
+func TestTreeSwitchWide(t *testing.T) {
+	tree := generic.New(sapling.New(wideKeys()...))
+	for b := 0; b < 256; b++ {
+		c, rest, found := tree.Switch(0, byte(b))
+		e := !found ||
+			rest != "" ||
+			tree.Chunk(c) != string([]byte{byte(b)})
+
+		if e {
+			t.Errorf(testTreeSwitchWideError, c, rest, found, b)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+// Package glob provides selection of keys in radix trees by glob patterns,
+// for example, `x-*-id`, `content-?ype` or `[a-c]*`. The patterns support the
+// following syntax.
+//
+//   - `*` matches any sequence of bytes, including empty one;
+//   - `?` matches any single byte;
+//   - `[...]` matches any single byte from the class in the brackets, where
+//     the class is a list of bytes and ranges of bytes like `a-z`; the class
+//     is negated, if it starts with `!` or `^`, and `]` is included into the
+//     class, if it goes first;
+//   - `\` escapes the next byte, so it is matched literally;
+//   - any other byte matches itself.
+//
+// Patterns are matched against whole keys. The matching walks a tree and
+// tracks positions in the pattern, which can be reached with the current
+// key prefix, so subtrees are skipped as soon as no positions are left. The
+// selection works with any implementation of [radixt.Tree] interface.
+package glob
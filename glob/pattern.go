@@ -0,0 +1,237 @@
+package glob
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorPattern is returned by [Compile] to indicate, that the provided
+// pattern is malformed.
+var ErrorPattern = errors.New("malformed pattern")
+
+// set is a set of bytes.
+type set [4]uint64
+
+func (s *set) add(b byte) {
+	s[b>>6] |= 1 << (b & 63)
+}
+
+func (s *set) has(b byte) bool {
+	return s[b>>6]&(1<<(b&63)) != 0
+}
+
+// all is set of all the bytes.
+var all = set{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}
+
+// token is either a star or a set of bytes, one of which should be matched.
+type token struct {
+	star  bool
+	bytes set
+}
+
+// Pattern is compiled glob pattern.
+type Pattern struct {
+	source string
+	tokens []token
+}
+
+// Compile parses the provided glob pattern and returns pointer on compiled
+// pattern with nil error, or nil pointer with error, which wraps
+// [ErrorPattern], if the pattern is malformed.
+func Compile(pattern string) (*Pattern, error) {
+	p := &Pattern{source: pattern}
+	for i := 0; i < len(pattern); i++ {
+		var t token
+		switch b := pattern[i]; b {
+		case '*':
+			l := len(p.tokens)
+			if l > 0 && p.tokens[l-1].star {
+				continue
+			}
+
+			t.star = true
+
+		case '?':
+			t.bytes = all
+
+		case '[':
+			j, err := class(pattern, i+1, &t.bytes)
+			if err != nil {
+				return nil, err
+			}
+
+			i = j
+
+		case '\\':
+			i++
+			if i == len(pattern) {
+				return nil, fmt.Errorf(
+					"%w: trailing backslash in %q",
+					ErrorPattern,
+					pattern,
+				)
+			}
+
+			t.bytes.add(pattern[i])
+
+		default:
+			t.bytes.add(b)
+		}
+
+		p.tokens = append(p.tokens, t)
+	}
+
+	return p, nil
+}
+
+// MustCompile is like [Compile], but panics if the provided pattern is
+// malformed.
+func MustCompile(pattern string) *Pattern {
+	p, err := Compile(pattern)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// class parses class of bytes of the provided pattern, which starts after
+// opening bracket at position i, into set s, and returns position of the
+// closing bracket with nil error, or error, if the class is malformed.
+func class(pattern string, i int, s *set) (int, error) {
+	start := i - 1
+	negated := i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^')
+	if negated {
+		i++
+	}
+
+	for first := i; i < len(pattern); i++ {
+		if pattern[i] == ']' && i > first {
+			break
+		}
+
+		low, j, ok := classByte(pattern, i)
+		high := low
+		if ok && j+2 < len(pattern) && pattern[j+1] == '-' {
+			if pattern[j+2] != ']' {
+				high, j, ok = classByte(pattern, j+2)
+			}
+		}
+
+		if !ok {
+			break
+		}
+
+		if high < low {
+			return 0, fmt.Errorf(
+				"%w: invalid range at %d in %q",
+				ErrorPattern,
+				i,
+				pattern,
+			)
+		}
+
+		for c := int(low); c <= int(high); c++ {
+			s.add(byte(c))
+		}
+
+		i = j
+	}
+
+	if i >= len(pattern) || pattern[i] != ']' {
+		return 0, fmt.Errorf(
+			"%w: unterminated class at %d in %q",
+			ErrorPattern,
+			start,
+			pattern,
+		)
+	}
+
+	if negated {
+		for k := range s {
+			s[k] = ^s[k]
+		}
+	}
+
+	return i, nil
+}
+
+// classByte returns byte of class at position i of the provided pattern,
+// taking escaping into account, with position of the last byte of the
+// representation and boolean truth, or false, if the pattern is over.
+func classByte(pattern string, i int) (b byte, j int, ok bool) {
+	if pattern[i] == '\\' {
+		i++
+	}
+
+	if i < len(pattern) {
+		b, j, ok = pattern[i], i, true
+	}
+
+	return
+}
+
+// String returns source of pattern p.
+func (p *Pattern) String() string {
+	return p.source
+}
+
+// MatchString returns if the provided string s matches pattern p.
+func (p *Pattern) MatchString(s string) bool {
+	w := len(p.tokens) + 1
+	states := make([]bool, w, 2*w)
+	p.start(states)
+	for i := 0; i < len(s); i++ {
+		states = states[:2*w]
+		if !p.step(states[:w], states[w:], s[i]) {
+			return false
+		}
+
+		copy(states, states[w:])
+		states = states[:w]
+	}
+
+	return states[len(p.tokens)]
+}
+
+// start fills the provided slice of states, which are positions in tokens of
+// pattern p, with positions, reachable with empty string.
+func (p *Pattern) start(states []bool) {
+	states[0] = true
+	p.close(states)
+}
+
+// step fills slice next of states with positions, reachable from positions
+// in slice prev with byte b, and returns if any position is reachable.
+func (p *Pattern) step(prev, next []bool, b byte) (alive bool) {
+	for i := range next {
+		next[i] = false
+	}
+
+	for i, t := range p.tokens {
+		switch {
+		case !prev[i]:
+		case t.star:
+			next[i] = true
+		case t.bytes.has(b):
+			next[i+1] = true
+		}
+	}
+
+	p.close(next)
+	for _, s := range next {
+		alive = alive || s
+	}
+
+	return
+}
+
+// close adds positions after stars to the provided slice of states, as stars
+// match empty strings.
+func (p *Pattern) close(states []bool) {
+	for i, t := range p.tokens {
+		if states[i] && t.star {
+			states[i+1] = true
+		}
+	}
+}
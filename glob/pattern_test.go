@@ -0,0 +1,116 @@
+package glob_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/glob"
+)
+
+var compileTests = []struct {
+	pattern string
+	err     error
+}{
+	{pattern: "", err: nil},
+	{pattern: "x-*-id", err: nil},
+	{pattern: "content-?ype", err: nil},
+	{pattern: "[a-c]*", err: nil},
+	{pattern: "[!a-c]*", err: nil},
+	{pattern: "[]]", err: nil},
+	{pattern: "[a-]", err: nil},
+	{pattern: "\\*", err: nil},
+	{pattern: "[\\]]", err: nil},
+	{pattern: "\\", err: glob.ErrorPattern},
+	{pattern: "[", err: glob.ErrorPattern},
+	{pattern: "[!", err: glob.ErrorPattern},
+	{pattern: "[]", err: glob.ErrorPattern},
+	{pattern: "[a-c", err: glob.ErrorPattern},
+	{pattern: "[a\\", err: glob.ErrorPattern},
+	{pattern: "[a-\\", err: glob.ErrorPattern},
+	{pattern: "[c-a]", err: glob.ErrorPattern},
+}
+
+const testCompileError = "Compile Test %d: got %v for error of compilation " +
+	"of %q (should be %v)"
+
+func TestCompile(t *testing.T) {
+	for i, tt := range compileTests {
+		p, err := glob.Compile(tt.pattern)
+		if !errors.Is(err, tt.err) || (err == nil) != (p != nil) {
+			t.Errorf(testCompileError, i, err, tt.pattern, tt.err)
+		}
+	}
+}
+
+const testMustCompileError = "Must Compile Test: got no panic for %q"
+
+func TestMustCompile(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf(testMustCompileError, "[")
+		}
+	}()
+
+	glob.MustCompile("[")
+}
+
+var patternMatchStringTests = []struct {
+	pattern string
+	s       string
+	result  bool
+}{
+	{pattern: "", s: "", result: true},
+	{pattern: "", s: "a", result: false},
+	{pattern: "*", s: "", result: true},
+	{pattern: "*", s: "anything", result: true},
+	{pattern: "**", s: "anything", result: true},
+	{pattern: "?", s: "", result: false},
+	{pattern: "?", s: "\xFF", result: true},
+	{pattern: "?", s: "ab", result: false},
+	{pattern: "x-*-id", s: "x-request-id", result: true},
+	{pattern: "x-*-id", s: "x--id", result: true},
+	{pattern: "x-*-id", s: "x-id", result: false},
+	{pattern: "x-*-id", s: "x-request-ids", result: false},
+	{pattern: "content-?ype", s: "content-type", result: true},
+	{pattern: "content-?ype", s: "content-hype", result: true},
+	{pattern: "content-?ype", s: "content-ype", result: false},
+	{pattern: "[a-c]*", s: "content-type", result: true},
+	{pattern: "[a-c]*", s: "host", result: false},
+	{pattern: "[!a-c]*", s: "host", result: true},
+	{pattern: "[^a-c]*", s: "content-type", result: false},
+	{pattern: "[]]", s: "]", result: true},
+	{pattern: "[a-]", s: "-", result: true},
+	{pattern: "[a-]", s: "b", result: false},
+	{pattern: "[\\]]", s: "]", result: true},
+	{pattern: "\\*", s: "*", result: true},
+	{pattern: "\\*", s: "a", result: false},
+	{pattern: "*a*b*", s: "xxaxxbxx", result: true},
+	{pattern: "*a*b*", s: "xxbxxaxx", result: false},
+}
+
+const testPatternMatchStringError = "Pattern Match String Test %d: got %t " +
+	"for matching of %q against %q (should be %t)"
+
+func TestPatternMatchString(t *testing.T) {
+	for i, tt := range patternMatchStringTests {
+		result := glob.MustCompile(tt.pattern).MatchString(tt.s)
+		if result != tt.result {
+			t.Errorf(
+				testPatternMatchStringError,
+				i,
+				result,
+				tt.s,
+				tt.pattern,
+				tt.result,
+			)
+		}
+	}
+}
+
+const testPatternStringError = "Pattern String Test: got %q (should be %q)"
+
+func TestPatternString(t *testing.T) {
+	if s := glob.MustCompile("x-*-id").String(); s != "x-*-id" {
+		t.Errorf(testPatternStringError, s, "x-*-id")
+	}
+}
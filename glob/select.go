@@ -0,0 +1,82 @@
+package glob
+
+import (
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/internal/order"
+	"github.com/alex-ilchukov/radixt/keys"
+)
+
+// Each calls function e just once for every key with value in the provided
+// tree t, which matches pattern p, in byte-lexicographic order of the keys,
+// until the function returns boolean truth. The function does nothing if t
+// is nil. The key slice is reused between the calls, so it is valid only
+// until e returns.
+func (p *Pattern) Each(t radixt.Tree, e func(key []byte, v uint) bool) {
+	if t == nil || t.Size() == 0 {
+		return
+	}
+
+	w := len(p.tokens) + 1
+	s := selector{p: p, t: t, e: e, states: make([]bool, w)}
+	s.blank = make([]bool, w)
+	p.start(s.states)
+	s.walk(0)
+}
+
+// Select returns keys with values of the provided tree t, which match pattern
+// p, in byte-lexicographic order of the keys. See [Pattern.Each] for more
+// details.
+func (p *Pattern) Select(t radixt.Tree) (result []keys.KV) {
+	p.Each(t, func(key []byte, v uint) bool {
+		result = append(result, keys.KV{Key: string(key), Value: v})
+		return false
+	})
+
+	return
+}
+
+// selector contains state of the selection: the current key and sets of
+// positions in the pattern tokens, reachable with every prefix of the key.
+type selector struct {
+	p      *Pattern
+	t      radixt.Tree
+	e      func(key []byte, v uint) bool
+	key    []byte
+	states []bool
+	blank  []bool
+}
+
+// walk goes through subtree of node n and returns boolean truth, if the user
+// function has asked to stop.
+func (s *selector) walk(n uint) bool {
+	w := len(s.p.tokens) + 1
+	l := len(s.key)
+	defer func() {
+		s.key = s.key[:l]
+		s.states = s.states[:(l+1)*w]
+	}()
+
+	chunk := s.t.Chunk(n)
+	for i := 0; i < len(chunk); i++ {
+		s.key = append(s.key, chunk[i])
+		k := len(s.key)
+		s.states = append(s.states, s.blank...)
+		prev := s.states[(k-1)*w : k*w]
+		if !s.p.step(prev, s.states[k*w:], chunk[i]) {
+			return false
+		}
+	}
+
+	final := s.states[len(s.states)-1]
+	if v, has := s.t.Value(n); has && final && s.e(s.key, v) {
+		return true
+	}
+
+	for _, c := range order.Children(s.t, n, nil) {
+		if s.walk(c) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,178 @@
+package glob_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/glob"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var (
+	headers = []string{
+		"accept",
+		"authority",
+		"authorization",
+		"author",
+		"content-length",
+		"content-type",
+		"host",
+		"x-forwarded-for",
+		"x-request-id",
+		"x-trace-id",
+	}
+
+	atree = sapling.New(headers...)
+	ctree = str4.MustCreate(atree)
+	btree = sapling.New("", "a")
+)
+
+var patternSelectTests = []struct {
+	pattern string
+	t       radixt.Tree
+	result  []keys.KV
+}{
+	{pattern: "*", t: nil, result: nil},
+	{pattern: "*", t: sapling.New(), result: nil},
+	{pattern: "nothing*", t: atree, result: nil},
+	{
+		pattern: "x-*-id",
+		t:       atree,
+		result: []keys.KV{
+			{Key: "x-request-id", Value: 8},
+			{Key: "x-trace-id", Value: 9},
+		},
+	},
+	{
+		pattern: "content-?ype",
+		t:       ctree,
+		result:  []keys.KV{{Key: "content-type", Value: 5}},
+	},
+	{
+		pattern: "[a-c]*",
+		t:       ctree,
+		result: []keys.KV{
+			{Key: "accept", Value: 0},
+			{Key: "author", Value: 3},
+			{Key: "authority", Value: 1},
+			{Key: "authorization", Value: 2},
+			{Key: "content-length", Value: 4},
+			{Key: "content-type", Value: 5},
+		},
+	},
+	{
+		pattern: "author?*",
+		t:       atree,
+		result: []keys.KV{
+			{Key: "authority", Value: 1},
+			{Key: "authorization", Value: 2},
+		},
+	},
+	{pattern: "", t: btree, result: []keys.KV{{Key: "", Value: 0}}},
+	{pattern: "?", t: btree, result: []keys.KV{{Key: "a", Value: 1}}},
+}
+
+const testPatternSelectError = "Pattern Select Test %d: got %v for %q " +
+	"(should be %v)"
+
+func TestPatternSelect(t *testing.T) {
+	for i, tt := range patternSelectTests {
+		result := glob.MustCompile(tt.pattern).Select(tt.t)
+		if !reflect.DeepEqual(result, tt.result) {
+			t.Errorf(
+				testPatternSelectError,
+				i,
+				result,
+				tt.pattern,
+				tt.result,
+			)
+		}
+	}
+}
+
+var patternEachTests = []struct {
+	pattern string
+	limit   int
+	result  string
+}{
+	{pattern: "*", limit: 1, result: "accept 0; "},
+	{
+		pattern: "x-*",
+		limit:   2,
+		result:  "x-forwarded-for 7; x-request-id 8; ",
+	},
+	{pattern: "*-id", limit: 5, result: "x-request-id 8; x-trace-id 9; "},
+}
+
+const testPatternEachError = "Pattern Each Test %d: got %q for %q with " +
+	"limit %d (should be %q)"
+
+func TestPatternEach(t *testing.T) {
+	for i, tt := range patternEachTests {
+		result := ""
+		limit := tt.limit
+		p := glob.MustCompile(tt.pattern)
+		p.Each(ctree, func(key []byte, v uint) bool {
+			result += fmt.Sprintf("%s %d; ", key, v)
+			limit--
+			return limit == 0
+		})
+
+		if result != tt.result {
+			t.Errorf(
+				testPatternEachError,
+				i,
+				result,
+				tt.pattern,
+				tt.limit,
+				tt.result,
+			)
+		}
+	}
+}
+
+var patterns = []string{
+	"",
+	"*",
+	"a*",
+	"*o*",
+	"?u*",
+	"[a-h]*[!a-z]*",
+	"*-*-*",
+	"x-*-id",
+	"*[nr]",
+	"content-?ype",
+}
+
+const testPatternSelectAllError = "Pattern Select All Test: got %v for %q " +
+	"(should be %v)"
+
+func TestPatternSelectAll(t *testing.T) {
+	for _, pattern := range patterns {
+		p := glob.MustCompile(pattern)
+
+		var expected []keys.KV
+		keys.Each(atree, func(key []byte, v uint) bool {
+			if p.MatchString(string(key)) {
+				kv := keys.KV{Key: string(key), Value: v}
+				expected = append(expected, kv)
+			}
+
+			return false
+		})
+
+		result := p.Select(ctree)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf(
+				testPatternSelectAllError,
+				result,
+				pattern,
+				expected,
+			)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// Package httpheader provides parser of HTTP/1.x header blocks, which looks up
+// header names in radix tree and yields values of interesting headers only.
+//
+// Header names are fed byte by byte into lookup in a tree of interesting
+// header names. As soon as the lookup tells, that a name is unknown, feeding
+// stops, but the rest of the name is still checked for whitespace, and the
+// value is dropped without trimming. Every line, interesting or not, is read
+// up to LF with optional CR before it, and a line, which starts with
+// whitespace, is rejected as obsolete line folding. Header names are
+// case-insensitive, so the tree should contain lower-case names, and the
+// parser folds every byte of the names fed (see package fold).
+//
+// The parser reads lines directly from buffer of [bufio.Reader] and does not
+// allocate memory. Values of headers, yielded by [Parser.Each], are valid
+// only until the callback returns, while [Parser.Fill] copies them into a
+// buffer, provided by user.
+package httpheader
@@ -0,0 +1,58 @@
+package httpheader
+
+// Default limits of header blocks, used by parser, if corresponding fields of
+// [Options] are zeros.
+const (
+	DefaultMaxLine  = 8 << 10
+	DefaultMaxLines = 128
+)
+
+// Duplicates is policy of [Parser.Fill] on duplicate interesting headers.
+type Duplicates int
+
+const (
+	// KeepFirst policy keeps the first value of a header.
+	KeepFirst Duplicates = iota
+
+	// KeepLast policy keeps the last value of a header.
+	KeepLast
+
+	// Join policy joins all the values of a header with comma and space
+	// between them, as it is allowed for list-based headers.
+	Join
+
+	// Reject policy makes [Parser.Fill] return [ErrorDuplicate].
+	Reject
+)
+
+// Options contains parameters of [Parser].
+type Options struct {
+	// MaxLine is maximum length of header line in bytes, including line
+	// terminator. Zero means [DefaultMaxLine]. Lines, longer than buffer
+	// of reader, are rejected too.
+	MaxLine int
+
+	// MaxLines is maximum amount of header lines in a block, excluding the
+	// terminating empty line. Zero means [DefaultMaxLines].
+	MaxLines int
+
+	// Duplicates is the policy of [Parser.Fill] on duplicate interesting
+	// headers.
+	Duplicates Duplicates
+}
+
+func (o Options) maxLine() int {
+	if o.MaxLine == 0 {
+		return DefaultMaxLine
+	}
+
+	return o.MaxLine
+}
+
+func (o Options) maxLines() int {
+	if o.MaxLines == 0 {
+		return DefaultMaxLines
+	}
+
+	return o.MaxLines
+}
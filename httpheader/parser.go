@@ -0,0 +1,273 @@
+package httpheader
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/fold"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/lookup"
+)
+
+var (
+	// ErrorNotFolded is returned by [New], if the tree has a key with
+	// upper-case ASCII letters.
+	ErrorNotFolded = errors.New("tree has key with upper-case letters")
+
+	// ErrorLineTooLong is returned, if a header line is longer than the
+	// limit or than buffer of reader.
+	ErrorLineTooLong = errors.New("header line is too long")
+
+	// ErrorTooManyLines is returned, if a header block has more lines than
+	// the limit.
+	ErrorTooManyLines = errors.New("header block has too many lines")
+
+	// ErrorObsFold is returned, if a header line starts with whitespace,
+	// that is, it is obsolete line folding or malformed first line.
+	ErrorObsFold = errors.New("obsolete line folding is not supported")
+
+	// ErrorMalformed is returned, if a header line has no colon, has empty
+	// header name or whitespace in the name.
+	ErrorMalformed = errors.New("malformed header line")
+
+	// ErrorDuplicate is returned by [Parser.Fill] with [Reject] policy, if
+	// an interesting header appears more than once.
+	ErrorDuplicate = errors.New("duplicate header")
+)
+
+// Parser contains state of parsing of HTTP/1.x header blocks. The state is
+// reused between blocks, so parser can not be used concurrently.
+type Parser struct {
+	l       *lookup.L
+	o       Options
+	offsets []offset
+}
+
+type offset struct {
+	start, end int
+	has        bool
+}
+
+// New creates and initializes new parser of header blocks accordingly to the
+// provided radix tree t of interesting header names and options o, and returns
+// a pointer to the parser with nil error. Values of the tree are indices of
+// headers for [Parser.Fill]. Nil values of t are supported and interpreted as
+// empty tree. It returns nil parser with [ErrorNotFolded], if the tree has key
+// with upper-case ASCII letters.
+func New(t radixt.Tree, o Options) (*Parser, error) {
+	folded := true
+	keys.Each(t, func(key []byte, _ uint) bool {
+		for _, b := range key {
+			if fold.ASCII[b] != b {
+				folded = false
+				return true
+			}
+		}
+
+		return false
+	})
+
+	if !folded {
+		return nil, ErrorNotFolded
+	}
+
+	return &Parser{l: lookup.New(t), o: o}, nil
+}
+
+// Each reads header block from r line by line, up to and including the
+// terminating empty line, and calls e with value v of every interesting
+// header name and its value with optional whitespace trimmed. The value is a
+// slice of buffer of r and valid only until e returns. Both CRLF and bare LF
+// are accepted as line terminators. If e returns true, the reading stops and
+// nil error is returned. It returns [io.EOF], if r has no data, and
+// [io.ErrUnexpectedEOF], if the block is not terminated. It returns
+// [ErrorLineTooLong], [ErrorTooManyLines], [ErrorObsFold] or
+// [ErrorMalformed], if the block violates limits or syntax, or error of
+// reading from r.
+func (p *Parser) Each(
+	r *bufio.Reader,
+	e func(v uint, value []byte) bool,
+) error {
+	maxLine := p.o.maxLine()
+	maxLines := p.o.maxLines()
+
+	for lines := 0; ; lines++ {
+		line, err := r.ReadSlice('\n')
+		switch {
+		case err == bufio.ErrBufferFull || len(line) > maxLine:
+			return ErrorLineTooLong
+
+		case err == io.EOF && lines == 0 && len(line) == 0:
+			return io.EOF
+
+		case err == io.EOF:
+			return io.ErrUnexpectedEOF
+
+		case err != nil:
+			return err
+		}
+
+		line = trimEOL(line)
+		if len(line) == 0 {
+			return nil
+		}
+
+		if lines == maxLines {
+			return ErrorTooManyLines
+		}
+
+		v, value, found, err := p.parse(line)
+		if err != nil {
+			return err
+		}
+
+		if found && e(v, value) {
+			return nil
+		}
+	}
+}
+
+// Fill reads header block from r in the same way as [Parser.Each] does, and
+// appends values of interesting headers to buf. For every header with value
+// v less than length of dst, it sets dst[v] to the header's value in the
+// resulting buffer, which is returned, accordingly to the duplicate policy of
+// the parser. Other elements of dst are set to nil, and headers with greater
+// values are ignored. Besides the errors of [Parser.Each], it returns
+// [ErrorDuplicate] with [Reject] policy. In case of any error contents of dst
+// are undefined.
+func (p *Parser) Fill(r *bufio.Reader, dst [][]byte, buf []byte) (
+	[]byte,
+	error,
+) {
+	p.prepare(len(dst))
+
+	var err error
+	policy := p.o.Duplicates
+	e := func(v uint, value []byte) bool {
+		if v >= uint(len(dst)) {
+			return false
+		}
+
+		o := &p.offsets[v]
+		switch {
+		case !o.has:
+			o.start = len(buf)
+			buf = append(buf, value...)
+
+		case policy == KeepFirst:
+			return false
+
+		case policy == KeepLast:
+			o.start = len(buf)
+			buf = append(buf, value...)
+
+		case policy == Join:
+			start := len(buf)
+			buf = append(buf, buf[o.start:o.end]...)
+			buf = append(buf, ", "...)
+			buf = append(buf, value...)
+			o.start = start
+
+		default:
+			err = ErrorDuplicate
+			return true
+		}
+
+		o.end = len(buf)
+		o.has = true
+		return false
+	}
+
+	if eachErr := p.Each(r, e); eachErr != nil {
+		return buf, eachErr
+	}
+
+	if err != nil {
+		return buf, err
+	}
+
+	for i, o := range p.offsets {
+		dst[i] = nil
+		if o.has {
+			dst[i] = buf[o.start:o.end:o.end]
+		}
+	}
+
+	return buf, nil
+}
+
+func (p *Parser) prepare(n int) {
+	if cap(p.offsets) < n {
+		p.offsets = make([]offset, n)
+	}
+
+	p.offsets = p.offsets[:n]
+	for i := range p.offsets {
+		p.offsets[i] = offset{}
+	}
+}
+
+func (p *Parser) parse(line []byte) (
+	v uint,
+	value []byte,
+	found bool,
+	err error,
+) {
+	if isWS(line[0]) {
+		return 0, nil, false, ErrorObsFold
+	}
+
+	i := bytes.IndexByte(line, ':')
+	if i <= 0 {
+		return 0, nil, false, ErrorMalformed
+	}
+
+	l := p.l
+	l.Reset()
+	keep := true
+	for j := 0; j < i; j++ {
+		b := line[j]
+		if isWS(b) {
+			return 0, nil, false, ErrorMalformed
+		}
+
+		if keep {
+			keep = l.Feed(fold.ASCII[b])
+		}
+	}
+
+	v, found = l.Value()
+	if !found {
+		return
+	}
+
+	return v, trimOWS(line[i+1:]), true, nil
+}
+
+func isWS(b byte) bool {
+	return b == ' ' || b == '\t'
+}
+
+func trimEOL(line []byte) []byte {
+	line = line[:len(line)-1]
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		line = line[:len(line)-1]
+	}
+
+	return line
+}
+
+func trimOWS(value []byte) []byte {
+	for len(value) > 0 && isWS(value[0]) {
+		value = value[1:]
+	}
+
+	for len(value) > 0 && isWS(value[len(value)-1]) {
+		value = value[:len(value)-1]
+	}
+
+	return value
+}
@@ -0,0 +1,83 @@
+package httpheader_test
+
+import (
+	"bufio"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/httpheader"
+)
+
+const browserBlock = "Host: example.com\r\n" +
+	"User-Agent: Mozilla/5.0 (X11; Linux x86_64; rv:109.0) " +
+	"Gecko/20100101 Firefox/115.0\r\n" +
+	"Accept: text/html,application/xhtml+xml,application/xml;q=0.9," +
+	"image/avif,image/webp,*/*;q=0.8\r\n" +
+	"Accept-Language: en-US,en;q=0.5\r\n" +
+	"Accept-Encoding: gzip, deflate, br\r\n" +
+	"Content-Type: image/png\r\n" +
+	"Content-Length: 4096\r\n" +
+	"Connection: keep-alive\r\n" +
+	"Cookie: session=0123456789abcdef0123456789abcdef; theme=dark\r\n" +
+	"Upgrade-Insecure-Requests: 1\r\n" +
+	"Sec-Fetch-Dest: document\r\n" +
+	"Sec-Fetch-Mode: navigate\r\n" +
+	"Sec-Fetch-Site: none\r\n" +
+	"Sec-Fetch-User: ?1\r\n" +
+	"\r\n"
+
+func BenchmarkParserFill(b *testing.B) {
+	p, _ := httpheader.New(names, httpheader.Options{})
+	sr := strings.NewReader(browserBlock)
+	r := bufio.NewReader(sr)
+	dst := make([][]byte, 4)
+	buf := make([]byte, 0, 256)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sr.Reset(browserBlock)
+		r.Reset(sr)
+		if _, err := p.Fill(r, dst, buf[:0]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParserEach(b *testing.B) {
+	p, _ := httpheader.New(names, httpheader.Options{})
+	sr := strings.NewReader(browserBlock)
+	r := bufio.NewReader(sr)
+	n := 0
+	e := func(v uint, value []byte) bool {
+		n += len(value)
+		return false
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sr.Reset(browserBlock)
+		r.Reset(sr)
+		if err := p.Each(r, e); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTextprotoReadMIMEHeader(b *testing.B) {
+	sr := strings.NewReader(browserBlock)
+	r := bufio.NewReader(sr)
+	tr := textproto.NewReader(r)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sr.Reset(browserBlock)
+		r.Reset(sr)
+		if _, err := tr.ReadMIMEHeader(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package httpheader_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/httpheader"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+const (
+	contentLength = iota
+	contentType
+	host
+	accept
+)
+
+var names = strg.MustCreate[strg.N3](sapling.New(
+	"content-length",
+	"content-type",
+	"host",
+	"accept",
+))
+
+const block = "Host: example.com\r\n" +
+	"User-Agent: curl/7.88.1\r\n" +
+	"CONTENT-type:   text/plain \t\r\n" +
+	"Content-Length: 12\n" +
+	"X-Unknown:\r\n" +
+	"\r\n" +
+	"Hello, World"
+
+type pair struct {
+	v     uint
+	value string
+}
+
+var eachTests = []struct {
+	input  string
+	o      httpheader.Options
+	result []pair
+	err    error
+}{
+	{input: "", result: nil, err: io.EOF},
+	{input: "\r\n", result: nil, err: nil},
+	{input: "\n", result: nil, err: nil},
+	{
+		input: block,
+		result: []pair{
+			{v: host, value: "example.com"},
+			{v: contentType, value: "text/plain"},
+			{v: contentLength, value: "12"},
+		},
+		err: nil,
+	},
+	{
+		input: "Accept: */*\r\nAccept:\r\n\r\n",
+		result: []pair{
+			{v: accept, value: "*/*"},
+			{v: accept, value: ""},
+		},
+		err: nil,
+	},
+	{
+		input:  "Host: example.com\r\n",
+		result: []pair{{v: host, value: "example.com"}},
+		err:    io.ErrUnexpectedEOF,
+	},
+	{
+		input:  "Host: example.com\r\n Folded\r\n\r\n",
+		result: []pair{{v: host, value: "example.com"}},
+		err:    httpheader.ErrorObsFold,
+	},
+	{
+		input:  " Host: example.com\r\n\r\n",
+		result: nil,
+		err:    httpheader.ErrorObsFold,
+	},
+	{
+		input:  "Host : example.com\r\n\r\n",
+		result: nil,
+		err:    httpheader.ErrorMalformed,
+	},
+	{
+		input:  ": example.com\r\n\r\n",
+		result: nil,
+		err:    httpheader.ErrorMalformed,
+	},
+	{
+		input:  "Host\r\n\r\n",
+		result: nil,
+		err:    httpheader.ErrorMalformed,
+	},
+	{
+		input:  "Host: example.com\r\nX-Long: 0123456789\r\n\r\n",
+		o:      httpheader.Options{MaxLine: 19},
+		result: []pair{{v: host, value: "example.com"}},
+		err:    httpheader.ErrorLineTooLong,
+	},
+	{
+		input:  "X-Long: " + strings.Repeat("0", 8<<10) + "\r\n\r\n",
+		result: nil,
+		err:    httpheader.ErrorLineTooLong,
+	},
+	{
+		input:  block,
+		o:      httpheader.Options{MaxLines: 2},
+		result: []pair{{v: host, value: "example.com"}},
+		err:    httpheader.ErrorTooManyLines,
+	},
+}
+
+const testEachError = "Each Test %d: got %v and error %v " +
+	"(should be %v and %v)"
+
+func TestEach(t *testing.T) {
+	for i, tt := range eachTests {
+		p, err := httpheader.New(names, tt.o)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var result []pair
+		r := bufio.NewReader(strings.NewReader(tt.input))
+		err = p.Each(r, func(v uint, value []byte) bool {
+			p := pair{v: v, value: string(value)}
+			result = append(result, p)
+			return false
+		})
+
+		e := !errors.Is(err, tt.err) ||
+			len(result) != len(tt.result) ||
+			(len(result) > 0 && !eqPairs(result, tt.result))
+
+		if e {
+			t.Errorf(
+				testEachError,
+				i,
+				result,
+				err,
+				tt.result,
+				tt.err,
+			)
+		}
+	}
+}
+
+func eqPairs(a, b []pair) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+const tail = "Content-Length: 12\nX-Unknown:\r\n\r\nHello, World"
+
+const testEachStopError = "Each Stop Test: got %v, error %v and rest %q"
+
+func TestEachStop(t *testing.T) {
+	p, _ := httpheader.New(names, httpheader.Options{})
+	r := bufio.NewReader(strings.NewReader(block))
+
+	var result []uint
+	err := p.Each(r, func(v uint, _ []byte) bool {
+		result = append(result, v)
+		return v == contentType
+	})
+
+	rest, _ := io.ReadAll(r)
+	e := err != nil ||
+		len(result) != 2 ||
+		string(rest) != tail
+
+	if e {
+		t.Errorf(testEachStopError, result, err, rest)
+	}
+}
+
+const duplicates = "Accept: text/html\r\n" +
+	"Host: example.com\r\n" +
+	"Accept: text/plain\r\n" +
+	"Accept: */*\r\n" +
+	"\r\n"
+
+var fillTests = []struct {
+	input  string
+	o      httpheader.Options
+	result [3]string
+	nils   [3]bool
+	err    error
+}{
+	{
+		input:  block,
+		result: [3]string{"12", "text/plain", "example.com"},
+		err:    nil,
+	},
+	{
+		input:  "\r\n",
+		result: [3]string{"", "", ""},
+		nils:   [3]bool{true, true, true},
+		err:    nil,
+	},
+	{
+		input:  "Content-Length:\r\n\r\n",
+		result: [3]string{"", "", ""},
+		nils:   [3]bool{false, true, true},
+		err:    nil,
+	},
+	{
+		input:  duplicates,
+		o:      httpheader.Options{Duplicates: httpheader.KeepFirst},
+		result: [3]string{"", "", "example.com"},
+		nils:   [3]bool{true, true, false},
+		err:    nil,
+	},
+	{
+		input:  "Host: a\r\nHost: b\r\nHost: c\r\n\r\n",
+		o:      httpheader.Options{Duplicates: httpheader.KeepFirst},
+		result: [3]string{"", "", "a"},
+		nils:   [3]bool{true, true, false},
+		err:    nil,
+	},
+	{
+		input:  "Host: a\r\nHost: b\r\nHost: c\r\n\r\n",
+		o:      httpheader.Options{Duplicates: httpheader.KeepLast},
+		result: [3]string{"", "", "c"},
+		nils:   [3]bool{true, true, false},
+		err:    nil,
+	},
+	{
+		input: "Host: a\r\n" +
+			"Content-Length: 1\r\n" +
+			"Host: b\r\n" +
+			"Host: c\r\n" +
+			"\r\n",
+		o:      httpheader.Options{Duplicates: httpheader.Join},
+		result: [3]string{"1", "", "a, b, c"},
+		nils:   [3]bool{false, true, false},
+		err:    nil,
+	},
+	{
+		input: "Host: a\r\nHost: b\r\n\r\n",
+		o:     httpheader.Options{Duplicates: httpheader.Reject},
+		err:   httpheader.ErrorDuplicate,
+	},
+	{
+		input: "Host: a\r\n",
+		err:   io.ErrUnexpectedEOF,
+	},
+}
+
+const testFillError = "Fill Test %d: got %q and error %v " +
+	"(should be %q, %v and %v)"
+
+func TestFill(t *testing.T) {
+	for i, tt := range fillTests {
+		p, _ := httpheader.New(names, tt.o)
+		r := bufio.NewReader(strings.NewReader(tt.input))
+		dst := [][]byte{[]byte("x"), []byte("x"), []byte("x")}
+		_, err := p.Fill(r, dst, make([]byte, 0, 2))
+
+		e := !errors.Is(err, tt.err)
+		if err == nil {
+			for j := range dst {
+				e = e ||
+					string(dst[j]) != tt.result[j] ||
+					(dst[j] == nil) != tt.nils[j]
+			}
+		}
+
+		if e {
+			t.Errorf(
+				testFillError,
+				i,
+				dst,
+				err,
+				tt.result,
+				tt.nils,
+				tt.err,
+			)
+		}
+	}
+}
+
+const testNewError = "New Test: got error %v (should be %v)"
+
+func TestNew(t *testing.T) {
+	_, err := httpheader.New(sapling.New("Host"), httpheader.Options{})
+	if err != httpheader.ErrorNotFolded {
+		t.Errorf(testNewError, err, httpheader.ErrorNotFolded)
+	}
+
+	_, err = httpheader.New(nil, httpheader.Options{})
+	if err != nil {
+		t.Errorf(testNewError, err, nil)
+	}
+}
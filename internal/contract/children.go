@@ -0,0 +1,46 @@
+package contract
+
+// Children checks structure of tree with the provided amount of nodes.
+// Function r returns low and high indices of children of a node, and function
+// first returns first byte of chunk of a node with boolean true flag, if the
+// chunk is not empty. The function checks, that children of all the nodes
+// partition all the non-root nodes in ascending order, so every node, but the
+// root, has the only parent with lesser index, and that first bytes of chunks
+// of every node's children are present and strictly ascend. It returns empty
+// reason, if the checks pass, or index n of the first violating node with
+// non-empty reason otherwise.
+func Children(
+	size uint,
+	r func(n uint) (low, high uint),
+	first func(n uint) (b byte, ok bool),
+) (n uint, reason string) {
+	expected := uint(1)
+	for ; n < size; n++ {
+		low, high := r(n)
+		if low == high {
+			continue
+		}
+
+		if low != expected || low <= n || high < low || high > size {
+			return n, "invalid children range"
+		}
+
+		prev := -1
+		for c := low; c < high; c++ {
+			b, ok := first(c)
+			if !ok || int(b) <= prev {
+				return c, "invalid first byte"
+			}
+
+			prev = int(b)
+		}
+
+		expected = high
+	}
+
+	if size > 0 && expected != size {
+		return expected, "node without parent"
+	}
+
+	return 0, ""
+}
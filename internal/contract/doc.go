@@ -0,0 +1,3 @@
+// Package contract provides checks of structure of radix trees, which are
+// shared by implementations, loading trees from untrusted input.
+package contract
@@ -0,0 +1,29 @@
+package contract
+
+// Parents checks parents of nodes of tree with the provided amount of nodes,
+// which has passed [Children] check. Function r returns low and high indices
+// of children of a node, and function parent returns parent of a node with
+// boolean true flag, if the node is not root. The function checks, that the
+// root has no parent and parent of every child is the node with the child. It
+// returns empty reason, if the checks pass, or index n of the first violating
+// node with non-empty reason otherwise.
+func Parents(
+	size uint,
+	r func(n uint) (low, high uint),
+	parent func(n uint) (p uint, ok bool),
+) (n uint, reason string) {
+	if _, ok := parent(0); size > 0 && ok {
+		return 0, "root with parent"
+	}
+
+	for ; n < size; n++ {
+		low, high := r(n)
+		for c := low; c < high; c++ {
+			if p, ok := parent(c); !ok || p != n {
+				return c, "invalid parent"
+			}
+		}
+	}
+
+	return 0, ""
+}
@@ -0,0 +1,106 @@
+package lockstep
+
+import (
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/internal/order"
+)
+
+// Cursor points to a position within chunk of node N of tree T, that is, Rest
+// is the part of the chunk after the position. Cursor with nil tree points to
+// nowhere.
+type Cursor struct {
+	T    radixt.Tree
+	N    uint
+	Rest string
+}
+
+// Root returns cursor, which points to the beginning of root chunk of tree t,
+// or the cursor, which points to nowhere, if the tree is nil or empty.
+func Root(t radixt.Tree) (c Cursor) {
+	if t != nil && t.Size() > 0 {
+		c = Cursor{T: t, Rest: t.Chunk(0)}
+	}
+
+	return
+}
+
+// Value returns value of node of cursor c, if the cursor points to the end of
+// the node chunk after the first l bytes of the rest.
+func (c Cursor) Value(l int) (v uint, has bool) {
+	if c.T != nil && l == len(c.Rest) {
+		v, has = c.T.Value(c.N)
+	}
+
+	return
+}
+
+// ends returns cursors, which go after the first l bytes of rest of cursor c.
+// If the bytes finish the rest, the cursors point to the beginnings of
+// children of the node in order of their first bytes.
+func (c Cursor) ends(l int) (cursors []Cursor) {
+	if c.T == nil {
+		return
+	}
+
+	if l < len(c.Rest) {
+		return []Cursor{{T: c.T, N: c.N, Rest: c.Rest[l:]}}
+	}
+
+	t := c.T
+	for _, n := range order.Children(t, c.N, nil) {
+		cursors = append(cursors, Cursor{T: t, N: n, Rest: t.Chunk(n)})
+	}
+
+	return
+}
+
+// Prefix returns length of common prefix of rests of cursors x and y. A
+// cursor, which points to nowhere, is supposed to have the same rest as the
+// other one.
+func Prefix(x, y Cursor) (l int) {
+	switch {
+	case x.T == nil:
+		return len(y.Rest)
+	case y.T == nil:
+		return len(x.Rest)
+	}
+
+	for l < len(x.Rest) && l < len(y.Rest) && x.Rest[l] == y.Rest[l] {
+		l++
+	}
+
+	return
+}
+
+// Next calls function e with every couple of cursors, which go after the
+// first l bytes of rests of cursors x and y respectively and correspond to the
+// same string, in byte-lexicographic order of the strings, until the function
+// returns boolean truth. A cursor of the couple points to nowhere, if its tree
+// does not have the string. It returns boolean truth, if the function has
+// asked to stop.
+func Next(x, y Cursor, l int, e func(a, b Cursor) bool) bool {
+	xs := x.ends(l)
+	ys := y.ends(l)
+	for len(xs) > 0 || len(ys) > 0 {
+		var a, b Cursor
+		switch {
+		case len(ys) == 0:
+			a, xs = xs[0], xs[1:]
+		case len(xs) == 0:
+			b, ys = ys[0], ys[1:]
+		case xs[0].Rest[0] < ys[0].Rest[0]:
+			a, xs = xs[0], xs[1:]
+		case ys[0].Rest[0] < xs[0].Rest[0]:
+			b, ys = ys[0], ys[1:]
+		default:
+			a, xs = xs[0], xs[1:]
+			b, ys = ys[0], ys[1:]
+		}
+
+		if e(a, b) {
+			return true
+		}
+	}
+
+	return false
+}
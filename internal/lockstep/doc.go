@@ -0,0 +1,5 @@
+// Package lockstep provides walk over two radix trees in lockstep, that is,
+// over the positions of both trees, which correspond to the same strings, in
+// byte-lexicographic order of the strings. The walk is shared by combination
+// and comparison of trees.
+package lockstep
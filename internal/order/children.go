@@ -0,0 +1,28 @@
+package order
+
+import "github.com/alex-ilchukov/radixt"
+
+// Children appends children of node n of tree t to the provided slice in
+// ascending order of first bytes of their chunks and returns the extended
+// slice. Only the appended part of the slice is sorted.
+func Children(t radixt.Tree, n uint, children []uint) []uint {
+	low := len(children)
+	t.EachChild(n, func(c uint) bool {
+		children = append(children, c)
+		return false
+	})
+
+	// Insertion sort: many implementations enumerate the children in order
+	// of their first bytes already.
+	for i := low + 1; i < len(children); i++ {
+		for j := i; j > low; j-- {
+			if t.Chunk(children[j-1])[0] < t.Chunk(children[j])[0] {
+				break
+			}
+
+			children[j-1], children[j] = children[j], children[j-1]
+		}
+	}
+
+	return children
+}
@@ -0,0 +1,99 @@
+package order_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/internal/order"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+// tree is a naive implementation of radixt.Tree, which enumerates children
+// of nodes in the provided order.
+type tree struct {
+	chunks   []string
+	children [][]uint
+}
+
+func (t *tree) Size() uint {
+	return uint(len(t.chunks))
+}
+
+func (t *tree) Value(n uint) (uint, bool) {
+	return 0, false
+}
+
+func (t *tree) Chunk(n uint) string {
+	if n < t.Size() {
+		return t.chunks[n]
+	}
+
+	return ""
+}
+
+func (t *tree) EachChild(n uint, e func(uint) bool) {
+	if n >= t.Size() {
+		return
+	}
+
+	for _, c := range t.children[n] {
+		if e(c) {
+			return
+		}
+	}
+}
+
+var unordered = &tree{
+	chunks:   []string{"", "c", "a", "d", "b"},
+	children: [][]uint{{1, 2, 3, 4}, nil, nil, nil, nil},
+}
+
+var childrenTests = []struct {
+	tree     radixt.Tree
+	n        uint
+	children []uint
+	result   []uint
+}{
+	{
+		tree:     sapling.New(),
+		n:        0,
+		children: nil,
+		result:   nil,
+	},
+	{
+		tree:     sapling.New("a", "b", "c"),
+		n:        0,
+		children: nil,
+		result:   []uint{1, 2, 3},
+	},
+	{
+		tree:     sapling.New("a", "b", "c"),
+		n:        1,
+		children: []uint{7},
+		result:   []uint{7},
+	},
+	{
+		tree:     unordered,
+		n:        0,
+		children: nil,
+		result:   []uint{2, 4, 1, 3},
+	},
+	{
+		tree:     unordered,
+		n:        0,
+		children: []uint{3, 1},
+		result:   []uint{3, 1, 2, 4, 1, 3},
+	},
+}
+
+const testChildrenError = "Children Test %d: got %v (should be %v)"
+
+func TestChildren(t *testing.T) {
+	for i, tt := range childrenTests {
+		result := order.Children(tt.tree, tt.n, tt.children)
+		if !reflect.DeepEqual(result, tt.result) {
+			t.Errorf(testChildrenError, i, result, tt.result)
+		}
+	}
+}
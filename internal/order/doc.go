@@ -0,0 +1,4 @@
+// Package order provides enumeration of children of radix tree nodes in order
+// of first bytes of their chunks, which is shared by the walks over trees in
+// byte-lexicographic order of keys.
+package order
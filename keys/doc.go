@@ -0,0 +1,11 @@
+// Package keys provides enumeration of keys with values, stored in radix
+// trees. Keys are enumerated in byte-lexicographic order, that is, every key
+// goes before all the keys, which it is a prefix of, and keys with common
+// prefix are ordered by the first bytes after the prefix.
+//
+// The enumeration works with any implementation of [radixt.Tree] interface
+// and reuses the same buffer for all the keys, so the keys, yielded to a user
+// function, are valid only until the function returns. Besides enumeration of
+// all the keys, the package provides enumeration of keys, which start with a
+// prefix, for example, for autocompletion.
+package keys
@@ -0,0 +1,58 @@
+package keys
+
+import (
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/internal/order"
+)
+
+// Each calls function e just once for every key with value in the provided
+// tree t in byte-lexicographic order of the keys, until the function returns
+// boolean truth. The function does nothing if t is nil. The key slice is
+// reused between the calls, so it is valid only until e returns.
+func Each(t radixt.Tree, e func(key []byte, v uint) bool) {
+	EachBelow(t, 0, nil, e)
+}
+
+// EachBelow calls function e just once for every key with value in subtree of
+// node n of the provided tree t in byte-lexicographic order of the keys, until
+// the function returns boolean truth. The provided prefix is supposed to be
+// key of parent of the node, that is, all chunks from root to the parent
+// combined, and the yielded keys start with it. The function does nothing if t
+// is nil or does not have the node. The key slice is reused between the
+// calls, so it is valid only until e returns.
+func EachBelow(
+	t radixt.Tree,
+	n uint,
+	prefix []byte,
+	e func(key []byte, v uint) bool,
+) {
+	if t == nil || n >= t.Size() {
+		return
+	}
+
+	type entry struct {
+		n uint
+		l int
+	}
+
+	key := append([]byte(nil), prefix...)
+	children := []uint{}
+
+	s := []entry{{n: n, l: len(key)}}
+	for len(s) > 0 {
+		a := s[len(s)-1]
+		s = s[:len(s)-1]
+
+		key = append(key[:a.l], t.Chunk(a.n)...)
+		if v, has := t.Value(a.n); has && e(key, v) {
+			return
+		}
+
+		// The children are pushed in reverse order, so the first of
+		// them is popped first.
+		children = order.Children(t, a.n, children[:0])
+		for i := len(children) - 1; i >= 0; i-- {
+			s = append(s, entry{n: children[i], l: len(key)})
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package keys_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/null"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var (
+	empty = sapling.New()
+
+	atree = sapling.New(
+		"authority",
+		"authorization",
+		"author",
+		"authentication",
+		"auth",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	)
+
+	withBlank = sapling.New("content-type", "", "content", "auth")
+
+	aresult = "" +
+		"auth: 4, " +
+		"authentication: 3, " +
+		"author: 2, " +
+		"authority: 0, " +
+		"authorization: 1, " +
+		"content-disposition: 7, " +
+		"content-length: 6, " +
+		"content-type: 5"
+)
+
+func each(t radixt.Tree, max int) (a string) {
+	keys.Each(t, func(key []byte, v uint) bool {
+		if a != "" {
+			a += ", "
+		}
+		a += fmt.Sprintf("%s: %d", key, v)
+
+		max--
+		return max == 0
+	})
+
+	return
+}
+
+var eachTests = []struct {
+	tree   radixt.Tree
+	max    int
+	result string
+}{
+	{tree: nil, max: -1, result: ""},
+	{tree: null.Tree, max: -1, result: ""},
+	{tree: empty, max: -1, result: ""},
+	{tree: atree, max: -1, result: aresult},
+	{tree: generic.New(atree), max: -1, result: aresult},
+	{tree: evident.New(atree), max: -1, result: aresult},
+	{tree: str3.MustCreate(atree), max: -1, result: aresult},
+	{tree: structg.MustCreate[uint32](atree), max: -1, result: aresult},
+	{tree: atree, max: 1, result: "auth: 4"},
+	{
+		tree:   atree,
+		max:    3,
+		result: "auth: 4, authentication: 3, author: 2",
+	},
+	{
+		tree:   withBlank,
+		max:    -1,
+		result: ": 1, auth: 3, content: 2, content-type: 0",
+	},
+	{tree: sapling.New("single"), max: -1, result: "single: 0"},
+}
+
+const testEachError = "Each Test %d: got '%s' (should be '%s')"
+
+func TestEach(t *testing.T) {
+	for i, tt := range eachTests {
+		result := each(tt.tree, tt.max)
+		if result != tt.result {
+			t.Errorf(testEachError, i, result, tt.result)
+		}
+	}
+}
+
+func eachBelow(t radixt.Tree, n uint, prefix string) (a string) {
+	keys.EachBelow(t, n, []byte(prefix), func(key []byte, v uint) bool {
+		if a != "" {
+			a += ", "
+		}
+		a += fmt.Sprintf("%s: %d", key, v)
+
+		return false
+	})
+
+	return
+}
+
+var eachBelowTests = []struct {
+	tree   radixt.Tree
+	n      uint
+	prefix string
+	result string
+}{
+	{tree: nil, n: 0, prefix: "", result: ""},
+	{tree: empty, n: 0, prefix: "", result: ""},
+	{tree: atree, n: 0, prefix: "", result: aresult},
+	{tree: atree, n: 11, prefix: "", result: ""},
+	{
+		tree:   atree,
+		n:      7,
+		prefix: "",
+		result: "" +
+			"content-disposition: 7, " +
+			"content-length: 6, " +
+			"content-type: 5",
+	},
+	{
+		tree:   atree,
+		n:      3,
+		prefix: "author",
+		result: "authority: 0, authorization: 1",
+	},
+	{tree: atree, n: 2, prefix: "X-", result: "X-zation: 1"},
+}
+
+const testEachBelowError = "EachBelow Test %d: got '%s' (should be '%s')"
+
+func TestEachBelow(t *testing.T) {
+	for i, tt := range eachBelowTests {
+		result := eachBelow(tt.tree, tt.n, tt.prefix)
+		if result != tt.result {
+			t.Errorf(testEachBelowError, i, result, tt.result)
+		}
+	}
+}
+
+func BenchmarkEach(b *testing.B) {
+	t := atree
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		keys.Each(t, func([]byte, uint) bool { return false })
+	}
+}
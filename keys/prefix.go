@@ -0,0 +1,50 @@
+package keys
+
+import (
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/lookup"
+)
+
+// KV represents a couple of key with its value in a tree.
+type KV struct {
+	Key   string
+	Value uint
+}
+
+// EachPrefixed calls function e just once for every key with value in the
+// provided tree t, which starts with the provided prefix, in
+// byte-lexicographic order of the keys, until the function returns boolean
+// truth. The node with the prefix (or with the prefix ending within the node's
+// chunk) is located in the same way as [lookup.L] does, so [lookup.Switcher]
+// implementation of the tree is used, if it is available. The function does
+// nothing if t is nil. The key slice is reused between the calls, so it is
+// valid only until e returns.
+func EachPrefixed(t radixt.Tree, prefix string, e func([]byte, uint) bool) {
+	l := lookup.New(t)
+	for i := 0; i < len(prefix); i++ {
+		if !l.Feed(prefix[i]) {
+			return
+		}
+	}
+
+	if t == nil || t.Size() == 0 {
+		return
+	}
+
+	n := l.Node()
+	fed := len(t.Chunk(n)) - len(l.Rest())
+	EachBelow(t, n, []byte(prefix[:len(prefix)-fed]), e)
+}
+
+// Prefixed returns keys with values of the provided tree t, which start with
+// the provided prefix, in byte-lexicographic order of the keys. If limit is
+// not zero, it returns no more than limit keys. See [EachPrefixed] for more
+// details.
+func Prefixed(t radixt.Tree, prefix string, limit uint) (result []KV) {
+	EachPrefixed(t, prefix, func(key []byte, v uint) bool {
+		result = append(result, KV{Key: string(key), Value: v})
+		return uint(len(result)) == limit
+	})
+
+	return
+}
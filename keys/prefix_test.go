@@ -0,0 +1,162 @@
+package keys_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/compact/struct32"
+	"github.com/alex-ilchukov/radixt/compact/struct64"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/null"
+)
+
+var implementations = []struct {
+	name string
+	tree radixt.Tree
+}{
+	{name: "sapling", tree: atree},
+	{name: "generic", tree: generic.New(atree)},
+	{name: "evident", tree: evident.New(atree)},
+	{name: "str3", tree: str3.MustCreate(atree)},
+	{name: "str4", tree: str4.MustCreate(atree)},
+	{name: "strg[N3]", tree: strg.MustCreate[strg.N3](atree)},
+	{name: "strg[N4]", tree: strg.MustCreate[strg.N4](atree)},
+	{name: "struct32", tree: struct32.MustCreate(atree)},
+	{name: "struct64", tree: struct64.MustCreate(atree)},
+	{name: "structg[uint32]", tree: structg.MustCreate[uint32](atree)},
+	{name: "structg[uint64]", tree: structg.MustCreate[uint64](atree)},
+}
+
+var prefixedTests = []struct {
+	prefix string
+	limit  uint
+	result []keys.KV
+}{
+	{
+		prefix: "",
+		limit:  0,
+		result: []keys.KV{
+			{Key: "auth", Value: 4},
+			{Key: "authentication", Value: 3},
+			{Key: "author", Value: 2},
+			{Key: "authority", Value: 0},
+			{Key: "authorization", Value: 1},
+			{Key: "content-disposition", Value: 7},
+			{Key: "content-length", Value: 6},
+			{Key: "content-type", Value: 5},
+		},
+	},
+	{
+		prefix: "content-",
+		limit:  0,
+		result: []keys.KV{
+			{Key: "content-disposition", Value: 7},
+			{Key: "content-length", Value: 6},
+			{Key: "content-type", Value: 5},
+		},
+	},
+	{
+		prefix: "cont",
+		limit:  2,
+		result: []keys.KV{
+			{Key: "content-disposition", Value: 7},
+			{Key: "content-length", Value: 6},
+		},
+	},
+	{
+		prefix: "authori",
+		limit:  0,
+		result: []keys.KV{
+			{Key: "authority", Value: 0},
+			{Key: "authorization", Value: 1},
+		},
+	},
+	{
+		prefix: "auth",
+		limit:  1,
+		result: []keys.KV{{Key: "auth", Value: 4}},
+	},
+	{
+		prefix: "content-type",
+		limit:  0,
+		result: []keys.KV{{Key: "content-type", Value: 5}},
+	},
+	{prefix: "content-types", limit: 0, result: nil},
+	{prefix: "content-w", limit: 0, result: nil},
+	{prefix: "x", limit: 0, result: nil},
+}
+
+const testPrefixedError = "Prefixed Test %d: for %s tree got %v for prefix " +
+	"'%s' and limit %d (should be %v)"
+
+func TestPrefixed(t *testing.T) {
+	for i, tt := range prefixedTests {
+		for _, im := range implementations {
+			result := keys.Prefixed(im.tree, tt.prefix, tt.limit)
+			if !reflect.DeepEqual(result, tt.result) {
+				t.Errorf(
+					testPrefixedError,
+					i,
+					im.name,
+					result,
+					tt.prefix,
+					tt.limit,
+					tt.result,
+				)
+			}
+		}
+	}
+}
+
+var prefixedSpecialTests = []struct {
+	tree   radixt.Tree
+	prefix string
+	result []keys.KV
+}{
+	{tree: nil, prefix: "", result: nil},
+	{tree: null.Tree, prefix: "", result: nil},
+	{tree: empty, prefix: "a", result: nil},
+	{
+		tree:   withBlank,
+		prefix: "",
+		result: []keys.KV{
+			{Key: "", Value: 1},
+			{Key: "auth", Value: 3},
+			{Key: "content", Value: 2},
+			{Key: "content-type", Value: 0},
+		},
+	},
+	{
+		tree:   withBlank,
+		prefix: "content",
+		result: []keys.KV{
+			{Key: "content", Value: 2},
+			{Key: "content-type", Value: 0},
+		},
+	},
+}
+
+const testPrefixedSpecialError = "Prefixed Special Test %d: got %v for " +
+	"prefix '%s' (should be %v)"
+
+func TestPrefixedSpecial(t *testing.T) {
+	for i, tt := range prefixedSpecialTests {
+		result := keys.Prefixed(tt.tree, tt.prefix, 0)
+		if !reflect.DeepEqual(result, tt.result) {
+			t.Errorf(
+				testPrefixedSpecialError,
+				i,
+				result,
+				tt.prefix,
+				tt.result,
+			)
+		}
+	}
+}
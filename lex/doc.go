@@ -0,0 +1,11 @@
+// Package lex provides tokenization of input by keys of radix trees with
+// maximal munch: every token is the longest key, which is a prefix of the rest
+// of the input. It fits lexers of small protocols and languages, which have a
+// fixed set of keywords and operators, like "<", "<=" and "<<=".
+//
+// [Tokenizer] is built on lookup state of package lookup and can be
+// used either directly with [Tokenizer.Token], which returns value and length
+// of the next token, or as [bufio.SplitFunc] with [Tokenizer.Split]. Bytes,
+// which start no key, are either reported as errors or passed through as
+// one-byte tokens without values.
+package lex
@@ -0,0 +1,111 @@
+package lex
+
+import (
+	"errors"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/lookup"
+)
+
+// ErrorUnknown is returned by [Tokenizer] in [Strict] mode, if input starts
+// with a byte, which starts no key.
+var ErrorUnknown = errors.New("unknown token")
+
+// Mode is mode of treating bytes, which start no key.
+type Mode int
+
+const (
+	// Strict mode makes tokenizer return [ErrorUnknown] for the bytes.
+	Strict Mode = iota
+
+	// PassThrough mode makes tokenizer return the bytes as one-byte tokens
+	// without values.
+	PassThrough
+)
+
+// Tokenizer splits input into tokens, which are keys of a radix tree, with
+// maximal munch. It keeps lookup state and the last token value, so it is not
+// safe to use by multiple goroutines concurrently.
+type Tokenizer struct {
+	p     *lookup.Longest
+	mode  Mode
+	v     uint
+	found bool
+}
+
+// New creates new tokenizer with keys of the provided tree t and mode m of
+// treating bytes, which start no key, and returns a pointer on the tokenizer.
+// Nil values of t are supported and interpreted as empty tree. The empty key
+// is never a token.
+func New(t radixt.Tree, m Mode) *Tokenizer {
+	return &Tokenizer{p: lookup.NewLongest(t), mode: m}
+}
+
+// Token looks up the longest key, which is a prefix of data, and returns its
+// value v with its length n, boolean true flag and nil error. If data is a
+// prefix of a longer key and atEOF is false, it returns zero length and nil
+// error, so the call should be repeated with more data. The tokenizer does
+// not wait for more data, if no key continues data. If no key is a prefix
+// of data, it returns default values with [ErrorUnknown] in [Strict] mode or
+// length 1 with found flag set to false and nil error in [PassThrough] mode.
+// Empty data gives zero length and nil error.
+func (z *Tokenizer) Token(data []byte, atEOF bool) (
+	v uint,
+	n int,
+	found bool,
+	err error,
+) {
+	z.p.Reset()
+	i := 0
+	for i < len(data) && z.p.Feed(data[i]) {
+		i++
+	}
+
+	if i > 0 && i == len(data) && !atEOF && z.p.Extensible() {
+		return 0, 0, false, nil
+	}
+
+	// Match of the empty key has zero length and is not a token.
+	v, n, found = z.p.Match()
+	switch {
+	case n > 0:
+	case len(data) == 0:
+		v, found = 0, false
+	case z.mode == Strict:
+		v, found, err = 0, false, ErrorUnknown
+	default:
+		v, n, found = 0, 1, false
+	}
+
+	return
+}
+
+// Split is [bufio.SplitFunc], which splits data into tokens (see
+// [Tokenizer.Token]). Value of the last returned token is available with
+// [Tokenizer.Value].
+func (z *Tokenizer) Split(data []byte, atEOF bool) (
+	advance int,
+	token []byte,
+	err error,
+) {
+	v, n, found, err := z.Token(data, atEOF)
+	if err != nil || n == 0 {
+		return 0, nil, err
+	}
+
+	z.v, z.found = v, found
+
+	return n, data[:n], nil
+}
+
+// Value returns value v of the last token, returned by [Tokenizer.Split], with
+// boolean true flag, if the token is a key, or default unsigned integer with
+// boolean false otherwise.
+func (z *Tokenizer) Value() (v uint, found bool) {
+	return z.v, z.found
+}
+
+// Tree returns radix tree.
+func (z *Tokenizer) Tree() radixt.Tree {
+	return z.p.Tree()
+}
@@ -0,0 +1,290 @@
+package lex_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/lex"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var (
+	operators = sapling.New("<", "<=", "<<", "<<=", "=", "==")
+
+	withBlank = str4.MustCreate(sapling.New("", "if", "in", "int"))
+)
+
+var tokenTests = []struct {
+	tree    radixt.Tree
+	mode    lex.Mode
+	data    string
+	atEOF   bool
+	result1 uint
+	result2 int
+	result3 bool
+	result4 error
+}{
+	{tree: nil, mode: lex.Strict, data: "", atEOF: false},
+	{tree: nil, mode: lex.Strict, data: "", atEOF: true},
+	{
+		tree:    nil,
+		mode:    lex.Strict,
+		data:    "<",
+		atEOF:   false,
+		result4: lex.ErrorUnknown,
+	},
+	{tree: nil, mode: lex.PassThrough, data: "<", atEOF: false, result2: 1},
+	{tree: operators, mode: lex.Strict, data: "", atEOF: false},
+	{tree: operators, mode: lex.Strict, data: "<", atEOF: false},
+	{tree: operators, mode: lex.Strict, data: "<<", atEOF: false},
+	{
+		tree:    operators,
+		mode:    lex.Strict,
+		data:    "<<",
+		atEOF:   true,
+		result1: 2,
+		result2: 2,
+		result3: true,
+	},
+	{
+		tree:    operators,
+		mode:    lex.Strict,
+		data:    "<<=",
+		atEOF:   false,
+		result1: 3,
+		result2: 3,
+		result3: true,
+	},
+	{
+		tree:    operators,
+		mode:    lex.Strict,
+		data:    "<==",
+		atEOF:   false,
+		result1: 1,
+		result2: 2,
+		result3: true,
+	},
+	{
+		tree:    operators,
+		mode:    lex.Strict,
+		data:    "<a",
+		atEOF:   false,
+		result1: 0,
+		result2: 1,
+		result3: true,
+	},
+	{
+		tree:    operators,
+		mode:    lex.Strict,
+		data:    "==",
+		atEOF:   false,
+		result1: 5,
+		result2: 2,
+		result3: true,
+	},
+	{
+		tree:    operators,
+		mode:    lex.Strict,
+		data:    "a<",
+		atEOF:   false,
+		result4: lex.ErrorUnknown,
+	},
+	{
+		tree:    operators,
+		mode:    lex.PassThrough,
+		data:    "a<",
+		atEOF:   false,
+		result2: 1,
+	},
+	{tree: withBlank, mode: lex.Strict, data: "i", atEOF: false},
+	{
+		tree:    withBlank,
+		mode:    lex.Strict,
+		data:    "i",
+		atEOF:   true,
+		result4: lex.ErrorUnknown,
+	},
+	{
+		tree:    withBlank,
+		mode:    lex.PassThrough,
+		data:    "i ",
+		atEOF:   false,
+		result2: 1,
+	},
+	{
+		tree:    withBlank,
+		mode:    lex.Strict,
+		data:    "in",
+		atEOF:   true,
+		result1: 2,
+		result2: 2,
+		result3: true,
+	},
+	{
+		tree:    withBlank,
+		mode:    lex.Strict,
+		data:    "int",
+		atEOF:   false,
+		result1: 3,
+		result2: 3,
+		result3: true,
+	},
+}
+
+const testTokenError = "Token Test %d: for data %q and EOF flag %t got %d, " +
+	"%d, %t, and %v (should be %d, %d, %t, and %v)"
+
+func TestToken(t *testing.T) {
+	for i, tt := range tokenTests {
+		z := lex.New(tt.tree, tt.mode)
+		result1, result2, result3, result4 := z.Token(
+			[]byte(tt.data),
+			tt.atEOF,
+		)
+
+		e := result1 != tt.result1 ||
+			result2 != tt.result2 ||
+			result3 != tt.result3 ||
+			result4 != tt.result4
+
+		if e {
+			t.Errorf(
+				testTokenError,
+				i,
+				tt.data,
+				tt.atEOF,
+				result1,
+				result2,
+				result3,
+				result4,
+				tt.result1,
+				tt.result2,
+				tt.result3,
+				tt.result4,
+			)
+		}
+	}
+}
+
+// token is a token, returned by scanner, with its value.
+type token struct {
+	s     string
+	v     uint
+	found bool
+}
+
+var splitTests = []struct {
+	tree   radixt.Tree
+	mode   lex.Mode
+	input  string
+	tokens []token
+	err    error
+}{
+	{tree: operators, mode: lex.Strict, input: "", tokens: nil, err: nil},
+	{
+		tree:  operators,
+		mode:  lex.Strict,
+		input: "<<=<==<",
+		tokens: []token{
+			{s: "<<=", v: 3, found: true},
+			{s: "<=", v: 1, found: true},
+			{s: "=", v: 4, found: true},
+			{s: "<", v: 0, found: true},
+		},
+		err: nil,
+	},
+	{
+		tree:  operators,
+		mode:  lex.Strict,
+		input: "<<a",
+		tokens: []token{
+			{s: "<<", v: 2, found: true},
+		},
+		err: lex.ErrorUnknown,
+	},
+	{
+		tree:  operators,
+		mode:  lex.PassThrough,
+		input: "a<=b",
+		tokens: []token{
+			{s: "a", v: 0, found: false},
+			{s: "<=", v: 1, found: true},
+			{s: "b", v: 0, found: false},
+		},
+		err: nil,
+	},
+	{
+		tree:  withBlank,
+		mode:  lex.PassThrough,
+		input: "if int i",
+		tokens: []token{
+			{s: "if", v: 1, found: true},
+			{s: " ", v: 0, found: false},
+			{s: "int", v: 3, found: true},
+			{s: " ", v: 0, found: false},
+			{s: "i", v: 0, found: false},
+		},
+		err: nil,
+	},
+}
+
+const testSplitError = "Split Test %d: for input %q got %v and error %v " +
+	"(should be %v and %v)"
+
+// TestSplit reads input byte by byte, so every token spans several refills
+// of buffer of the scanner.
+func TestSplit(t *testing.T) {
+	for i, tt := range splitTests {
+		z := lex.New(tt.tree, tt.mode)
+		r := iotest.OneByteReader(strings.NewReader(tt.input))
+		s := bufio.NewScanner(r)
+		s.Buffer(make([]byte, 1), 16)
+		s.Split(z.Split)
+
+		var tokens []token
+		for s.Scan() {
+			v, found := z.Value()
+			tk := token{s: s.Text(), v: v, found: found}
+			tokens = append(tokens, tk)
+		}
+
+		if !eqTokens(tokens, tt.tokens) || s.Err() != tt.err {
+			t.Errorf(
+				testSplitError,
+				i,
+				tt.input,
+				tokens,
+				s.Err(),
+				tt.tokens,
+				tt.err,
+			)
+		}
+	}
+}
+
+func eqTokens(a, b []token) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+const testTreeError = "Tree Test: got %v (should be %v)"
+
+func TestTree(t *testing.T) {
+	z := lex.New(operators, lex.Strict)
+	if result := z.Tree(); result != operators {
+		t.Errorf(testTreeError, result, operators)
+	}
+}
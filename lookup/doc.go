@@ -1,2 +1,11 @@
 // Package lookup provides an implementation of string lookup in radix tree.
+//
+// State of [L] can be saved and restored, so parsers can try alternatives and
+// backtrack without feeding the same bytes again, and lookup can start from
+// any node of the tree with [L.ResetAt].
+//
+// Besides the exact lookup of [L], the package provides longest-prefix lookup
+// of [Longest], which finds the longest key, being a prefix of the fed bytes.
+// Function [ReadUntil] looks up keys, which are read from [io.ByteReader] and
+// terminated by a delimiter.
 package lookup
@@ -29,11 +29,48 @@ func New(t radixt.Tree) *L {
 	return l
 }
 
+// State is a snapshot of the lookup state, taken by [L.State] and restored by
+// [L.Restore]. It is small and comparable, so it can be stored in slices or
+// used as a key of maps. The snapshot is meaningful only for lookups over the
+// same tree.
+type State struct {
+	n     uint
+	chunk string
+	keep  bool
+}
+
 // Reset resets the lookup state.
 func (l *L) Reset() {
-	l.n = 0
-	l.chunk = l.t.Chunk(0)
-	l.keep = l.t.Size() > 0
+	l.ResetAt(0)
+}
+
+// ResetAt resets the lookup state to the beginning of chunk of node n, so the
+// following bytes are looked up in the subtree of the node. If the tree does
+// not have the node, the lookup state does not find any byte.
+func (l *L) ResetAt(n uint) {
+	if n < l.t.Size() {
+		l.n = n
+		l.chunk = l.t.Chunk(n)
+		l.keep = true
+	} else {
+		l.n = 0
+		l.chunk = ""
+		l.keep = false
+	}
+}
+
+// State returns snapshot of the lookup state.
+func (l *L) State() State {
+	return State{n: l.n, chunk: l.chunk, keep: l.keep}
+}
+
+// Restore restores the lookup state from snapshot s, returned by [L.State] of
+// a lookup over the same tree. So, a user can try alternatives and backtrack
+// without feeding the same bytes again.
+func (l *L) Restore(s State) {
+	l.n = s.n
+	l.chunk = s.chunk
+	l.keep = s.keep
 }
 
 func (l *L) try(b byte, n uint, chunk string) {
@@ -55,6 +92,7 @@ func (l *L) Feed(b byte) bool {
 	case l.s != nil:
 		l.n, l.chunk, l.keep = l.s.Switch(l.n, b)
 	default:
+		l.keep = false
 		l.t.EachChild(l.n, func(c uint) bool {
 			l.try(b, c, l.t.Chunk(c))
 			return l.keep
@@ -67,8 +105,16 @@ func (l *L) Feed(b byte) bool {
 // Found returns if the lookup state points to result string with value in the
 // tree or not.
 func (l *L) Found() (found bool) {
+	_, found = l.Value()
+	return
+}
+
+// Value returns value v of result string with boolean true flag, if the lookup
+// state points to result string with value in the tree, or default unsigned
+// integer with boolean false otherwise.
+func (l *L) Value() (v uint, found bool) {
 	if l.keep && l.chunk == "" {
-		_, found = l.t.Value(l.n)
+		v, found = l.t.Value(l.n)
 	}
 
 	return
@@ -83,3 +129,8 @@ func (l *L) Tree() radixt.Tree {
 func (l *L) Node() uint {
 	return l.n
 }
+
+// Rest returns the rest of chunk of current tree node, which is not fed yet.
+func (l *L) Rest() string {
+	return l.chunk
+}
@@ -54,6 +54,7 @@ var lFeedTests = []struct {
 	{tree: atree, input: "content-", result: true},
 	{tree: atree, input: "auth", result: true},
 	{tree: atree, input: "authe", result: false},
+	{tree: atree, input: "content-types", result: false},
 	{tree: withBlank, input: "a", result: true},
 	{tree: withBlank, input: "b", result: false},
 	{tree: withBlank, input: "c", result: true},
@@ -226,3 +227,93 @@ func TestLNode(t *testing.T) {
 		}
 	}
 }
+
+var lValueTests = []struct {
+	tree    radixt.Tree
+	input   string
+	result1 uint
+	result2 bool
+}{
+	{tree: nil, input: "", result1: 0, result2: false},
+	{tree: nil, input: "content-type", result1: 0, result2: false},
+	{tree: empty, input: "", result1: 0, result2: false},
+	{tree: empty, input: "content-type", result1: 0, result2: false},
+	{tree: atree, input: "authorization", result1: 0, result2: true},
+	{tree: atree, input: "content-type", result1: 1, result2: true},
+	{tree: atree, input: "content-length", result1: 2, result2: true},
+	{tree: atree, input: "content-disposition", result1: 3, result2: true},
+	{tree: atree, input: "content-typ", result1: 0, result2: false},
+	{tree: atree, input: "content-", result1: 0, result2: false},
+	{tree: atree, input: "content-types", result1: 0, result2: false},
+	{tree: atree, input: "", result1: 0, result2: false},
+	{tree: withBlank, input: "content-length", result1: 2, result2: true},
+	{tree: withBlank, input: "auth", result1: 0, result2: false},
+	{tree: withBlank, input: "", result1: 4, result2: true},
+}
+
+const testLValueError = "Test L Value %d: for input data %s got %d and %t " +
+	"(should be %d and %t)"
+
+func TestLValue(t *testing.T) {
+	for i, tt := range lValueTests {
+		tree := tt.tree
+		input := tt.input
+		l := lookup.New(tree)
+
+		for j := 0; j < len(input); j++ {
+			l.Feed(input[j])
+		}
+
+		result1, result2 := l.Value()
+		if result1 != tt.result1 || result2 != tt.result2 {
+			t.Errorf(
+				testLValueError,
+				i,
+				tt.input,
+				result1,
+				result2,
+				tt.result1,
+				tt.result2,
+			)
+		}
+	}
+}
+
+var lRestTests = []struct {
+	tree   radixt.Tree
+	input  string
+	result string
+}{
+	{tree: nil, input: "", result: ""},
+	{tree: nil, input: "content-type", result: ""},
+	{tree: empty, input: "", result: ""},
+	{tree: atree, input: "", result: ""},
+	{tree: atree, input: "auth", result: "orization"},
+	{tree: atree, input: "authorization", result: ""},
+	{tree: atree, input: "content", result: "-"},
+	{tree: atree, input: "content-", result: ""},
+	{tree: atree, input: "content-t", result: "ype"},
+	{tree: atree, input: "content-w", result: ""},
+	{tree: sapling.New("auth"), input: "", result: "auth"},
+	{tree: sapling.New("auth"), input: "au", result: "th"},
+}
+
+const testLRestError = "Test L Rest %d: for input data %s got '%s' " +
+	"(should be '%s')"
+
+func TestLRest(t *testing.T) {
+	for i, tt := range lRestTests {
+		tree := tt.tree
+		input := tt.input
+		l := lookup.New(tree)
+
+		for j := 0; j < len(input); j++ {
+			l.Feed(input[j])
+		}
+
+		result := l.Rest()
+		if result != tt.result {
+			t.Errorf(testLRestError, i, tt.input, result, tt.result)
+		}
+	}
+}
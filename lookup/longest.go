@@ -0,0 +1,101 @@
+package lookup
+
+import "github.com/alex-ilchukov/radixt"
+
+// Longest contains information on state of the longest-prefix lookup process.
+// Besides the current state of lookup, it remembers the last (and, as a
+// corollary, the longest) found key, that is, amount of bytes fed at the
+// moment the key was found and its value. So, a user can feed bytes until
+// failure and retrieve the best match after that.
+type Longest struct {
+	l     L
+	fed   int
+	n     int
+	v     uint
+	found bool
+}
+
+// NewLongest creates and initializes new longest-prefix lookup state
+// accordingly to the provided radix tree t, and returns a pointer to the
+// state. Nil values of t are supported and interpreted as empty tree.
+func NewLongest(t radixt.Tree) *Longest {
+	p := &Longest{l: *New(t)}
+	p.Reset()
+
+	return p
+}
+
+// Reset resets the lookup state, including the remembered match.
+func (p *Longest) Reset() {
+	p.l.Reset()
+	p.fed = 0
+	p.n = 0
+	p.v, p.found = p.l.Value()
+}
+
+// Feed takes byte b and returns if the byte is found in radix tree accordingly
+// to the state or not. If the byte completes a key with value, the key is
+// remembered as the longest match.
+func (p *Longest) Feed(b byte) bool {
+	if !p.l.Feed(b) {
+		return false
+	}
+
+	p.fed++
+	if v, found := p.l.Value(); found {
+		p.n = p.fed
+		p.v = v
+		p.found = true
+	}
+
+	return true
+}
+
+// Match returns value v of the longest found key with amount n of its bytes
+// and boolean true flag, if a key has been found since the last reset, or
+// default values otherwise.
+func (p *Longest) Match() (v uint, n int, found bool) {
+	if p.found {
+		v, n, found = p.v, p.n, true
+	}
+
+	return
+}
+
+// Extensible returns if the lookup state can find more bytes, that is, if the
+// fed bytes are found and continued by a longer key.
+func (p *Longest) Extensible() (result bool) {
+	switch {
+	case !p.l.keep:
+		return false
+	case p.l.chunk != "":
+		return true
+	}
+
+	p.l.t.EachChild(p.l.n, func(uint) bool {
+		result = true
+		return true
+	})
+
+	return
+}
+
+// Tree returns radix tree.
+func (p *Longest) Tree() radixt.Tree {
+	return p.l.Tree()
+}
+
+// Match resets the provided longest-prefix lookup state p, feeds it with bytes
+// of s until failure or the end of s, and returns value v of the longest key,
+// which is a prefix of s, with length n of the key and boolean true flag. If no
+// key is a prefix of s, it returns default values.
+func Match[S ~string | ~[]byte](p *Longest, s S) (v uint, n int, found bool) {
+	p.Reset()
+	for i := 0; i < len(s); i++ {
+		if !p.Feed(s[i]) {
+			break
+		}
+	}
+
+	return p.Match()
+}
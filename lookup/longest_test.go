@@ -0,0 +1,186 @@
+package lookup_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/lookup"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var operators = sapling.New("<", "<=", "<<", "<<=", "=", "==")
+
+var longestTests = []struct {
+	tree    radixt.Tree
+	input   string
+	result1 uint
+	result2 int
+	result3 bool
+}{
+	{tree: nil, input: "", result1: 0, result2: 0, result3: false},
+	{tree: nil, input: "a", result1: 0, result2: 0, result3: false},
+	{tree: empty, input: "a", result1: 0, result2: 0, result3: false},
+	{tree: atree, input: "", result1: 0, result2: 0, result3: false},
+	{tree: atree, input: "auth", result1: 0, result2: 0, result3: false},
+	{
+		tree:    atree,
+		input:   "authorization: Basic",
+		result1: 0,
+		result2: 13,
+		result3: true,
+	},
+	{
+		tree:    atree,
+		input:   "content-types",
+		result1: 1,
+		result2: 12,
+		result3: true,
+	},
+	{tree: withBlank, input: "", result1: 4, result2: 0, result3: true},
+	{tree: withBlank, input: "auth", result1: 4, result2: 0, result3: true},
+	{
+		tree:    withBlank,
+		input:   "content-length!",
+		result1: 2,
+		result2: 14,
+		result3: true,
+	},
+	{tree: operators, input: "<", result1: 0, result2: 1, result3: true},
+	{tree: operators, input: "<<", result1: 2, result2: 2, result3: true},
+	{tree: operators, input: "<<=", result1: 3, result2: 3, result3: true},
+	{tree: operators, input: "<<<", result1: 2, result2: 2, result3: true},
+	{tree: operators, input: "<=<", result1: 1, result2: 2, result3: true},
+	{tree: operators, input: "=!", result1: 4, result2: 1, result3: true},
+	{tree: operators, input: "!=", result1: 0, result2: 0, result3: false},
+	{
+		tree:    strg.MustCreate[strg.N3](operators),
+		input:   "<<=<",
+		result1: 3,
+		result2: 3,
+		result3: true,
+	},
+}
+
+const testLongestError = "Test Longest %d: for input data %s got %d, %d, " +
+	"and %t (should be %d, %d, and %t)"
+
+func TestLongest(t *testing.T) {
+	for i, tt := range longestTests {
+		p := lookup.NewLongest(tt.tree)
+		for j := 0; j < len(tt.input); j++ {
+			p.Feed(tt.input[j])
+		}
+
+		result1, result2, result3 := p.Match()
+		if result1 != tt.result1 ||
+			result2 != tt.result2 ||
+			result3 != tt.result3 {
+			t.Errorf(
+				testLongestError,
+				i,
+				tt.input,
+				result1,
+				result2,
+				result3,
+				tt.result1,
+				tt.result2,
+				tt.result3,
+			)
+		}
+	}
+}
+
+const testMatchError = "Test Match %d: for input data %s got %d, %d, and " +
+	"%t (should be %d, %d, and %t)"
+
+func TestMatch(t *testing.T) {
+	for i, tt := range longestTests {
+		p := lookup.NewLongest(tt.tree)
+
+		// Dirty state should be reset
+		p.Feed('<')
+		p.Feed('c')
+
+		for _, m := range [...]func() (uint, int, bool){
+			func() (uint, int, bool) {
+				return lookup.Match(p, tt.input)
+			},
+			func() (uint, int, bool) {
+				return lookup.Match(p, []byte(tt.input))
+			},
+		} {
+			result1, result2, result3 := m()
+			if result1 != tt.result1 ||
+				result2 != tt.result2 ||
+				result3 != tt.result3 {
+				t.Errorf(
+					testMatchError,
+					i,
+					tt.input,
+					result1,
+					result2,
+					result3,
+					tt.result1,
+					tt.result2,
+					tt.result3,
+				)
+			}
+		}
+	}
+}
+
+var extensibleTests = []struct {
+	tree   radixt.Tree
+	input  string
+	result bool
+}{
+	{tree: nil, input: "", result: false},
+	{tree: empty, input: "", result: false},
+	{tree: operators, input: "", result: true},
+	{tree: operators, input: "<", result: true},
+	{tree: operators, input: "<<=", result: false},
+	{tree: operators, input: "==", result: false},
+	{tree: operators, input: "!", result: false},
+	{tree: operators, input: "<!", result: false},
+	{tree: atree, input: "auth", result: true},
+	{tree: atree, input: "autho", result: true},
+	{tree: atree, input: "content-type", result: false},
+	{
+		tree:   strg.MustCreate[strg.N3](operators),
+		input:  "<<",
+		result: true,
+	},
+}
+
+const testExtensibleError = "Test Extensible %d: for input data %s got %t " +
+	"(should be %t)"
+
+func TestExtensible(t *testing.T) {
+	for i, tt := range extensibleTests {
+		p := lookup.NewLongest(tt.tree)
+		for j := 0; j < len(tt.input); j++ {
+			p.Feed(tt.input[j])
+		}
+
+		result := p.Extensible()
+		if result != tt.result {
+			t.Errorf(
+				testExtensibleError,
+				i,
+				tt.input,
+				result,
+				tt.result,
+			)
+		}
+	}
+}
+
+const testLongestTreeError = "Test Longest Tree: got %v (should be %v)"
+
+func TestLongestTree(t *testing.T) {
+	p := lookup.NewLongest(atree)
+	if p.Tree() != atree {
+		t.Errorf(testLongestTreeError, p.Tree(), atree)
+	}
+}
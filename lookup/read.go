@@ -0,0 +1,123 @@
+package lookup
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+)
+
+// ErrorTooLong is returned by [ReadUntil], if the delimiter is not met within
+// the maximum length of key.
+var ErrorTooLong = errors.New("key is longer than the maximum length")
+
+// Feeder is the interface of lookup processes, which are fed byte by byte.
+// Lookup state [L] implements the interface, as well as the folding lookup
+// of package fold and the lookups, generated by package fossil.
+type Feeder interface {
+	// Reset resets the lookup state.
+	Reset()
+
+	// Feed takes byte b and returns if the byte is found accordingly to the
+	// state or not.
+	Feed(b byte) bool
+
+	// Value returns value v of the key with boolean true flag, if the state
+	// points to key with value, or default unsigned integer with boolean
+	// false otherwise.
+	Value() (v uint, found bool)
+}
+
+// ReadUntil resets lookup f, reads bytes from r and feeds them to f until
+// delimiter delim is read. It returns value v and found flag of the key,
+// preceding the delimiter, amount n of bytes consumed from r, including the
+// delimiter, and nil error. Once f stops to find the fed bytes, the rest of
+// the unknown key is skipped without buffering. Non-negative max limits
+// length of the key: if max+1 bytes are read and none of them is the
+// delimiter, [ErrorTooLong] is returned. Negative max means no limit. Error
+// of reading from r is returned as is. In case of any error v and found are
+// default values. If r is a [bufio.Reader], bytes are scanned in its buffer
+// directly.
+func ReadUntil(f Feeder, r io.ByteReader, delim byte, max int) (
+	v uint,
+	found bool,
+	n int,
+	err error,
+) {
+	f.Reset()
+
+	if br, ok := r.(*bufio.Reader); ok {
+		n, err = readBuffered(f, br, delim, max)
+	} else {
+		n, err = read(f, r, delim, max)
+	}
+
+	if err == nil {
+		v, found = f.Value()
+	}
+
+	return
+}
+
+func read(f Feeder, r io.ByteReader, delim byte, max int) (int, error) {
+	keep := true
+	for n := 0; ; n++ {
+		if n == max+1 && max >= 0 {
+			return n, ErrorTooLong
+		}
+
+		b, err := r.ReadByte()
+		if err != nil {
+			return n, err
+		}
+
+		if b == delim {
+			return n + 1, nil
+		}
+
+		if keep {
+			keep = f.Feed(b)
+		}
+	}
+}
+
+func readBuffered(f Feeder, r *bufio.Reader, delim byte, max int) (
+	int,
+	error,
+) {
+	keep := true
+	n := 0
+	for {
+		if r.Buffered() == 0 {
+			if _, err := r.Peek(1); err != nil {
+				return n, err
+			}
+		}
+
+		buf, _ := r.Peek(r.Buffered())
+		if max >= 0 && len(buf) > max+1-n {
+			buf = buf[:max+1-n]
+		}
+
+		i := bytes.IndexByte(buf, delim)
+		key := buf
+		if i >= 0 {
+			key = buf[:i]
+		}
+
+		for j := 0; keep && j < len(key); j++ {
+			keep = f.Feed(key[j])
+		}
+
+		if i >= 0 {
+			r.Discard(i + 1)
+			return n + i + 1, nil
+		}
+
+		r.Discard(len(buf))
+		n += len(buf)
+		if n == max+1 && max >= 0 {
+			return n, ErrorTooLong
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package lookup_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/lookup"
+)
+
+type byteReader struct {
+	r io.ByteReader
+}
+
+func (br byteReader) ReadByte() (byte, error) {
+	return br.r.ReadByte()
+}
+
+var readUntilTests = []struct {
+	tree  radixt.Tree
+	input string
+	max   int
+	v     uint
+	found bool
+	n     int
+	err   error
+	rest  string
+}{
+	{
+		tree:  nil,
+		input: "",
+		max:   -1,
+		v:     0,
+		found: false,
+		n:     0,
+		err:   io.EOF,
+		rest:  "",
+	},
+	{
+		tree:  nil,
+		input: ":",
+		max:   -1,
+		v:     0,
+		found: false,
+		n:     1,
+		err:   nil,
+		rest:  "",
+	},
+	{
+		tree:  withBlank,
+		input: ": value",
+		max:   0,
+		v:     4,
+		found: true,
+		n:     1,
+		err:   nil,
+		rest:  " value",
+	},
+	{
+		tree:  atree,
+		input: "content-length: 12",
+		max:   -1,
+		v:     2,
+		found: true,
+		n:     15,
+		err:   nil,
+		rest:  " 12",
+	},
+	{
+		tree:  atree,
+		input: "content-length: 12",
+		max:   14,
+		v:     2,
+		found: true,
+		n:     15,
+		err:   nil,
+		rest:  " 12",
+	},
+	{
+		tree:  atree,
+		input: "content-length: 12",
+		max:   13,
+		v:     0,
+		found: false,
+		n:     14,
+		err:   lookup.ErrorTooLong,
+		rest:  ": 12",
+	},
+	{
+		tree:  atree,
+		input: "content-lengths: 12",
+		max:   -1,
+		v:     0,
+		found: false,
+		n:     16,
+		err:   nil,
+		rest:  " 12",
+	},
+	{
+		tree:  atree,
+		input: "x-unknown-header-name: 1",
+		max:   64,
+		v:     0,
+		found: false,
+		n:     22,
+		err:   nil,
+		rest:  " 1",
+	},
+	{
+		tree:  atree,
+		input: "content",
+		max:   64,
+		v:     0,
+		found: false,
+		n:     7,
+		err:   io.EOF,
+		rest:  "",
+	},
+	{
+		tree:  strg.MustCreate[strg.N3](atree),
+		input: "authorization:Basic",
+		max:   13,
+		v:     0,
+		found: true,
+		n:     14,
+		err:   nil,
+		rest:  "Basic",
+	},
+}
+
+const testReadUntilError = "ReadUntil Test %d (%s): got %d, %t, %d, %v " +
+	"and rest %q (should be %d, %t, %d, %v and %q)"
+
+func TestReadUntil(t *testing.T) {
+	readers := []struct {
+		name string
+		new  func(s string) io.ByteReader
+	}{
+		{
+			name: "byte reader",
+			new: func(s string) io.ByteReader {
+				return byteReader{r: strings.NewReader(s)}
+			},
+		},
+		{
+			name: "bufio reader",
+			new: func(s string) io.ByteReader {
+				return bufio.NewReader(strings.NewReader(s))
+			},
+		},
+		{
+			name: "small bufio reader",
+			new: func(s string) io.ByteReader {
+				r := &slowReader{s: s}
+				return bufio.NewReaderSize(r, 16)
+			},
+		},
+	}
+
+	for _, rr := range readers {
+		for i, tt := range readUntilTests {
+			r := rr.new(tt.input)
+			l := lookup.New(tt.tree)
+			v, found, n, err := lookup.ReadUntil(l, r, ':', tt.max)
+			rest := restOf(r)
+
+			e := v != tt.v ||
+				found != tt.found ||
+				n != tt.n ||
+				!errors.Is(err, tt.err) ||
+				rest != tt.rest
+
+			if e {
+				t.Errorf(
+					testReadUntilError,
+					i,
+					rr.name,
+					v,
+					found,
+					n,
+					err,
+					rest,
+					tt.v,
+					tt.found,
+					tt.n,
+					tt.err,
+					tt.rest,
+				)
+			}
+		}
+	}
+}
+
+type slowReader struct {
+	s string
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	if len(r.s) == 0 {
+		return 0, io.EOF
+	}
+
+	if len(p) > 3 {
+		p = p[:3]
+	}
+
+	n := copy(p, r.s)
+	r.s = r.s[n:]
+	return n, nil
+}
+
+func restOf(r io.ByteReader) string {
+	var b strings.Builder
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return b.String()
+		}
+
+		b.WriteByte(c)
+	}
+}
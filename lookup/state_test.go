@@ -0,0 +1,143 @@
+package lookup_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/lookup"
+)
+
+var lResetAtTests = []struct {
+	tree    radixt.Tree
+	n       uint
+	input   string
+	result1 uint
+	result2 bool
+}{
+	{tree: nil, n: 0, input: "", result1: 0, result2: false},
+	{tree: empty, n: 0, input: "", result1: 0, result2: false},
+	{tree: atree, n: 0, input: "content-type", result1: 1, result2: true},
+	{tree: atree, n: 2, input: "content-type", result1: 1, result2: true},
+	{tree: atree, n: 2, input: "type", result1: 0, result2: false},
+	{tree: atree, n: 3, input: "type", result1: 1, result2: true},
+	{tree: atree, n: 3, input: "typ", result1: 0, result2: false},
+	{tree: atree, n: 1, input: "authorization", result1: 0, result2: true},
+	{tree: atree, n: 6, input: "", result1: 0, result2: false},
+	{tree: withBlank, n: 0, input: "", result1: 4, result2: true},
+	{tree: withBlank, n: 6, input: "", result1: 0, result2: false},
+}
+
+const testLResetAtError = "Test L ResetAt %d: for node %d and input data %s " +
+	"got %d and %t (should be %d and %t)"
+
+func TestLResetAt(t *testing.T) {
+	for i, tt := range lResetAtTests {
+		l := lookup.New(tt.tree)
+		l.Feed('c')
+		l.ResetAt(tt.n)
+
+		for j := 0; j < len(tt.input); j++ {
+			l.Feed(tt.input[j])
+		}
+
+		result1, result2 := l.Value()
+		if result1 != tt.result1 || result2 != tt.result2 {
+			t.Errorf(
+				testLResetAtError,
+				i,
+				tt.n,
+				tt.input,
+				result1,
+				result2,
+				tt.result1,
+				tt.result2,
+			)
+		}
+	}
+}
+
+var lRestoreTests = []struct {
+	input   string
+	result1 uint
+	result2 bool
+}{
+	{input: "type", result1: 1, result2: true},
+	{input: "width", result1: 0, result2: false},
+	{input: "length", result1: 2, result2: true},
+	{input: "", result1: 0, result2: false},
+	{input: "disposition", result1: 3, result2: true},
+	{input: "types", result1: 0, result2: false},
+}
+
+const testLRestoreError = "Test L Restore %d: for input data content-%s " +
+	"got %d and %t (should be %d and %t)"
+
+// TestLRestore feeds the common prefix once and tries all the alternatives,
+// restoring the lookup state after every one.
+func TestLRestore(t *testing.T) {
+	l := lookup.New(atree)
+	for _, b := range []byte("content-") {
+		l.Feed(b)
+	}
+
+	s := l.State()
+	for i, tt := range lRestoreTests {
+		l.Restore(s)
+		for j := 0; j < len(tt.input); j++ {
+			l.Feed(tt.input[j])
+		}
+
+		result1, result2 := l.Value()
+		if result1 != tt.result1 || result2 != tt.result2 {
+			t.Errorf(
+				testLRestoreError,
+				i,
+				tt.input,
+				result1,
+				result2,
+				tt.result1,
+				tt.result2,
+			)
+		}
+	}
+}
+
+const testLStateError = "Test L State: got %d different states " +
+	"(should be %d)"
+
+// TestLState verifies, that the same positions give the same states, however
+// they are reached, and that all failed states are the same too.
+func TestLState(t *testing.T) {
+	states := map[lookup.State]bool{}
+
+	l := lookup.New(atree)
+	states[l.State()] = true
+
+	for _, b := range []byte("content-") {
+		l.Feed(b)
+	}
+
+	states[l.State()] = true
+
+	l.ResetAt(2)
+	states[l.State()] = true
+
+	for _, b := range []byte("content-") {
+		l.Feed(b)
+	}
+
+	states[l.State()] = true
+
+	l.Reset()
+	states[l.State()] = true
+
+	l.Feed('x')
+	states[l.State()] = true
+
+	l.ResetAt(6)
+	states[l.State()] = true
+
+	if len(states) != 4 {
+		t.Errorf(testLStateError, len(states), 4)
+	}
+}
@@ -0,0 +1,10 @@
+package radixt
+
+// Parenter is ancillary interface for radix tree implementations, which can
+// go upward from a node to its parent.
+type Parenter interface {
+	// Parent should return parent p of node n with boolean true flag, if
+	// the tree has the node and the node is not root, or default unsigned
+	// integer with boolean false otherwise.
+	Parent(n uint) (p uint, ok bool)
+}
@@ -0,0 +1,12 @@
+// Package reverse provides reverse lookup in radix trees: reconstruction of
+// keys by node indices or by values. Radix trees can not go upward from a
+// node, so the package builds index for a tree with parent of every node and
+// the node for every value. Keys are reconstructed by concatenation of chunks
+// of the node and its ancestors.
+//
+// The index works with any implementation of [radixt.Tree] interface. It is
+// built in one pass over the nodes in order of their indices, which serve
+// contract of the interface, so it takes just a couple of slices: parents of
+// nodes and, if values are dense enough, nodes of values; sparse values are
+// kept in a map.
+package reverse
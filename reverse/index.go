@@ -0,0 +1,153 @@
+package reverse
+
+import "github.com/alex-ilchukov/radixt"
+
+// Index contains information on parents of nodes and on nodes of values of a
+// tree. The index does not follow changes of the tree, so it should be built
+// again, if the tree is changed.
+type Index struct {
+	t       radixt.Tree
+	parents []uint
+	dense   []uint
+	sparse  map[uint]uint
+}
+
+// New builds index for the provided tree t and returns a pointer on the index.
+// Nil values of t are interpreted as empty trees. If several nodes have the
+// same value, the value is associated with the node of the least index.
+func New(t radixt.Tree) *Index {
+	x := &Index{t: t}
+	if t == nil || t.Size() == 0 {
+		return x
+	}
+
+	size := t.Size()
+	x.parents = make([]uint, size)
+	high := uint(0)
+	amount := uint(0)
+	for n := uint(0); n < size; n++ {
+		t.EachChild(n, func(c uint) bool {
+			x.parents[c] = n
+			return false
+		})
+
+		if v, has := t.Value(n); has {
+			amount++
+			if v > high {
+				high = v
+			}
+		}
+	}
+
+	// Values are kept in a slice, which takes no more than twice as much
+	// as the nodes, or in a map otherwise. Nodes are stored incremented to
+	// tell them from absent ones.
+	if high < 2*amount {
+		x.dense = make([]uint, high+1)
+	} else {
+		x.sparse = make(map[uint]uint, amount)
+	}
+
+	for n := size; n > 0; n-- {
+		v, has := t.Value(n - 1)
+		switch {
+		case !has:
+		case x.dense != nil:
+			x.dense[v] = n
+		default:
+			x.sparse[v] = n
+		}
+	}
+
+	return x
+}
+
+// Tree returns the indexed tree.
+func (x *Index) Tree() radixt.Tree {
+	return x.t
+}
+
+// Parent returns parent p of node n with boolean true flag, if the tree has
+// the node and the node is not the root, or zero with false otherwise.
+func (x *Index) Parent(n uint) (p uint, ok bool) {
+	if n > 0 && n < uint(len(x.parents)) {
+		p, ok = x.parents[n], true
+	}
+
+	return
+}
+
+// Node returns node n with value v and boolean true flag, if the tree has a
+// node with the value, or zero with false otherwise.
+func (x *Index) Node(v uint) (n uint, found bool) {
+	if x.dense != nil {
+		if v < uint(len(x.dense)) {
+			n = x.dense[v]
+		}
+	} else {
+		n = x.sparse[v]
+	}
+
+	if n > 0 {
+		n, found = n-1, true
+	}
+
+	return
+}
+
+// Key returns key of node n, that is, all chunks from the root to the node
+// combined, with boolean true flag, if the tree has the node, or empty string
+// with false otherwise.
+func (x *Index) Key(n uint) (key string, ok bool) {
+	if n >= uint(len(x.parents)) {
+		return
+	}
+
+	return string(x.AppendKey(nil, n)), true
+}
+
+// KeyOf returns key of the node with value v with boolean true flag, if the
+// tree has a node with the value, or empty string with false otherwise.
+func (x *Index) KeyOf(v uint) (key string, found bool) {
+	n, found := x.Node(v)
+	if found {
+		key, found = x.Key(n)
+	}
+
+	return
+}
+
+// AppendKey appends key of node n to the provided dst slice and returns the
+// result. It returns dst as is, if the tree does not have the node. Unlike
+// [Index.Key], the method itself does not allocate memory, if the slice has
+// enough capacity, though Chunk method of some tree implementations does.
+func (x *Index) AppendKey(dst []byte, n uint) []byte {
+	if n >= uint(len(x.parents)) {
+		return dst
+	}
+
+	l := 0
+	for m := n; ; m = x.parents[m] {
+		l += len(x.t.Chunk(m))
+		if m == 0 {
+			break
+		}
+	}
+
+	start := len(dst)
+	for i := 0; i < l; i++ {
+		dst = append(dst, 0)
+	}
+
+	key := dst[start:]
+	for m := n; ; m = x.parents[m] {
+		chunk := x.t.Chunk(m)
+		l -= len(chunk)
+		copy(key[l:], chunk)
+		if m == 0 {
+			break
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,228 @@
+package reverse_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/compact/struct32"
+	"github.com/alex-ilchukov/radixt/compact/struct64"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/lookup"
+	"github.com/alex-ilchukov/radixt/null"
+	"github.com/alex-ilchukov/radixt/reverse"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var (
+	atree = sapling.New(
+		"authority",
+		"authorization",
+		"author",
+		"authentication",
+		"auth",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	)
+
+	sparse = sapling.NewFromSV(
+		sapling.SV{S: "", V: 1 << 40},
+		sapling.SV{S: "host", V: 7},
+		sapling.SV{S: "hosts", V: 1 << 20},
+	)
+
+	duplicates = sapling.NewFromSV(
+		sapling.SV{S: "b", V: 1},
+		sapling.SV{S: "a", V: 1},
+		sapling.SV{S: "c", V: 2},
+	)
+
+	trees = []radixt.Tree{
+		nil,
+		null.Tree,
+		atree,
+		sparse,
+		evident.New(atree),
+		generic.New(atree),
+		generic.New(sparse),
+		str3.MustCreate(atree),
+		str4.MustCreate(atree),
+		strg.MustCreate[strg.N3](atree),
+		strg.MustCreate[strg.N4](atree),
+		struct32.MustCreate(atree),
+		struct64.MustCreate(atree),
+		structg.MustCreate[uint32](atree),
+		structg.MustCreate[uint64](atree),
+	}
+)
+
+const testIndexKeyOfError = "Index Key Of Test %d: got %q and %t for key " +
+	"of value %d (should be %q and true)"
+
+func TestIndexKeyOf(t *testing.T) {
+	for i, tree := range trees {
+		x := reverse.New(tree)
+		keys.Each(tree, func(key []byte, v uint) bool {
+			result, found := x.KeyOf(v)
+			if result != string(key) || !found {
+				t.Errorf(
+					testIndexKeyOfError,
+					i,
+					result,
+					found,
+					v,
+					key,
+				)
+			}
+
+			return false
+		})
+	}
+}
+
+const testIndexKeyError = "Index Key Test %d: got %q for key of node %d, " +
+	"which leads to node %d with rest %q (should lead to the node with " +
+	"empty rest)"
+
+func TestIndexKey(t *testing.T) {
+	for i, tree := range trees {
+		if tree == nil {
+			continue
+		}
+
+		x := reverse.New(tree)
+		l := lookup.New(tree)
+		for n := uint(0); n < tree.Size(); n++ {
+			key, _ := x.Key(n)
+			l.Reset()
+			for j := 0; j < len(key); j++ {
+				l.Feed(key[j])
+			}
+
+			if l.Node() != n || l.Rest() != "" {
+				t.Errorf(
+					testIndexKeyError,
+					i,
+					key,
+					n,
+					l.Node(),
+					l.Rest(),
+				)
+			}
+		}
+	}
+}
+
+var indexNodeTests = []struct {
+	tree radixt.Tree
+	n    uint
+	key  string
+	ok   bool
+	p    uint
+	hasP bool
+}{
+	{tree: nil, n: 0, key: "", ok: false, p: 0, hasP: false},
+	{tree: atree, n: 0, key: "", ok: true, p: 0, hasP: false},
+	{tree: atree, n: 1, key: "authority", ok: true, p: 3, hasP: true},
+	{tree: atree, n: 3, key: "authori", ok: true, p: 4, hasP: true},
+	{tree: atree, n: 7, key: "content-", ok: true, p: 0, hasP: true},
+	{tree: atree, n: 11, key: "", ok: false, p: 0, hasP: false},
+	{tree: sparse, n: 2, key: "hosts", ok: true, p: 1, hasP: true},
+}
+
+const testIndexNodeError = "Index Node Test %d: got (%q, %t) and (%d, %t) " +
+	"for key and parent of node %d (should be (%q, %t) and (%d, %t))"
+
+func TestIndexNode(t *testing.T) {
+	for i, tt := range indexNodeTests {
+		x := reverse.New(tt.tree)
+		key, ok := x.Key(tt.n)
+		p, hasP := x.Parent(tt.n)
+		e := key != tt.key ||
+			ok != tt.ok ||
+			p != tt.p ||
+			hasP != tt.hasP
+
+		if e {
+			t.Errorf(
+				testIndexNodeError,
+				i,
+				key,
+				ok,
+				p,
+				hasP,
+				tt.n,
+				tt.key,
+				tt.ok,
+				tt.p,
+				tt.hasP,
+			)
+		}
+	}
+}
+
+var indexValueTests = []struct {
+	tree  radixt.Tree
+	v     uint
+	n     uint
+	found bool
+}{
+	{tree: nil, v: 0, n: 0, found: false},
+	{tree: atree, v: 0, n: 1, found: true},
+	{tree: atree, v: 4, n: 6, found: true},
+	{tree: atree, v: 8, n: 0, found: false},
+	{tree: sparse, v: 1 << 40, n: 0, found: true},
+	{tree: sparse, v: 7, n: 1, found: true},
+	{tree: sparse, v: 8, n: 0, found: false},
+	{tree: duplicates, v: 1, n: 1, found: true},
+	{tree: duplicates, v: 2, n: 3, found: true},
+	{tree: duplicates, v: 3, n: 0, found: false},
+}
+
+const testIndexValueError = "Index Value Test %d: got %d and %t for node " +
+	"of value %d (should be %d and %t)"
+
+func TestIndexValue(t *testing.T) {
+	for i, tt := range indexValueTests {
+		n, found := reverse.New(tt.tree).Node(tt.v)
+		if n != tt.n || found != tt.found {
+			t.Errorf(
+				testIndexValueError,
+				i,
+				n,
+				found,
+				tt.v,
+				tt.n,
+				tt.found,
+			)
+		}
+	}
+}
+
+const testIndexAppendKeyError = "Index Append Key Test: got %q and %v " +
+	"allocations (should be %q and 0)"
+
+func TestIndexAppendKey(t *testing.T) {
+	x := reverse.New(atree)
+	n, _ := x.Node(1)
+	buf := make([]byte, 0, 64)
+	result := x.AppendKey(buf, n)
+	allocs := testing.AllocsPerRun(10, func() {
+		x.AppendKey(buf, n)
+	})
+
+	if string(result) != "authorization" || allocs != 0 {
+		t.Errorf(
+			testIndexAppendKeyError,
+			result,
+			allocs,
+			"authorization",
+		)
+	}
+}
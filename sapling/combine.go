@@ -0,0 +1,128 @@
+package sapling
+
+import (
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/internal/lockstep"
+)
+
+// Union creates a new sapling tree with all the strings and their values from
+// the provided trees t and u, and returns a pointer on the tree. If both trees
+// have the same string with values, function resolve is called with the string
+// and values from t and u respectively to get value in the resulting tree. The
+// function is not called if it is nil and the value from t is taken instead.
+// The provided string slice is reused between the calls, so it is valid only
+// until resolve returns. Nil values of t and u are interpreted as empty trees.
+func Union(
+	t, u radixt.Tree,
+	resolve func(s []byte, v, w uint) uint,
+) *Tree {
+	c := combiner{
+		keep:    func(inT, inU bool) bool { return inT || inU },
+		resolve: resolve,
+	}
+
+	return c.do(t, u)
+}
+
+// Intersection creates a new sapling tree with the strings, which both the
+// provided trees t and u have with values, and returns a pointer on the tree.
+// Values of the strings are taken from t. Nil values of t and u are
+// interpreted as empty trees.
+func Intersection(t, u radixt.Tree) *Tree {
+	c := combiner{keep: func(inT, inU bool) bool { return inT && inU }}
+
+	return c.do(t, u)
+}
+
+// Difference creates a new sapling tree with the strings with values from the
+// provided tree t, which tree u does not have with values, and returns a
+// pointer on the tree. Nil values of t and u are interpreted as empty trees.
+func Difference(t, u radixt.Tree) *Tree {
+	c := combiner{keep: func(inT, inU bool) bool { return inT && !inU }}
+
+	return c.do(t, u)
+}
+
+type combiner struct {
+	keep    func(inT, inU bool) bool
+	resolve func(s []byte, v, w uint) uint
+	key     []byte
+	result  *Tree
+}
+
+func (c *combiner) do(t, u radixt.Tree) *Tree {
+	c.result = new(Tree)
+	if _, ok := c.combine(lockstep.Root(t), lockstep.Root(u)); ok {
+		c.result.renumber()
+	} else {
+		c.result.nodes = nil
+	}
+
+	return c.result
+}
+
+// combine walks from the positions of cursors x and y, which are over trees t
+// and u respectively and correspond to the same string, and appends node for
+// the common part of the cursor rests with nodes of its subtree to the
+// resulting tree. It returns index of the appended node and boolean truth, if
+// the node is required, or false otherwise.
+func (c *combiner) combine(x, y lockstep.Cursor) (n uint, ok bool) {
+	switch {
+	case x.T == nil && y.T == nil:
+		return
+	case x.T == nil && !c.keep(false, true):
+		return
+	case y.T == nil && !c.keep(true, false):
+		return
+	}
+
+	l := lockstep.Prefix(x, y)
+	chunk := x.Rest
+	if x.T == nil {
+		chunk = y.Rest
+	}
+
+	chunk = chunk[:l]
+
+	n = uint(len(c.result.nodes))
+	c.result.nodes = append(c.result.nodes, node{chunk: chunk})
+	key := len(c.key)
+	c.key = append(c.key, chunk...)
+
+	v, inT := x.Value(l)
+	w, inU := y.Value(l)
+	if c.keep(inT, inU) {
+		if !inT {
+			v = w
+		} else if inU && c.resolve != nil {
+			v = c.resolve(c.key, v, w)
+		}
+
+		c.result.nodes[n].value = v
+		c.result.nodes[n].hasValue = true
+	}
+
+	lockstep.Next(x, y, l, func(a, b lockstep.Cursor) bool {
+		if m, ok := c.combine(a, b); ok {
+			no := &c.result.nodes[n]
+			no.children = append(no.children, m)
+		}
+
+		return false
+	})
+
+	c.key = c.key[:key]
+
+	no := c.result.nodes[n]
+	switch {
+	case no.hasValue:
+		ok = true
+	case len(no.children) == 0:
+		ok = false
+	default:
+		c.result.squeeze(n)
+		ok = true
+	}
+
+	return
+}
@@ -0,0 +1,254 @@
+package sapling_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+var (
+	headers = sapling.NewFromSV(
+		sapling.SV{S: "authority", V: 1},
+		sapling.SV{S: "author", V: 2},
+		sapling.SV{S: "content-length", V: 3},
+		sapling.SV{S: "content-type", V: 4},
+	)
+
+	others = generic.New(sapling.NewFromSV(
+		sapling.SV{S: "auth", V: 10},
+		sapling.SV{S: "author", V: 20},
+		sapling.SV{S: "content-type", V: 40},
+		sapling.SV{S: "x-request-id", V: 50},
+	))
+
+	withBlankKey = sapling.NewFromSV(
+		sapling.SV{S: "", V: 100},
+		sapling.SV{S: "author", V: 200},
+	)
+)
+
+func sum(s []byte, v, w uint) uint {
+	return v + w
+}
+
+var unionTests = []struct {
+	t       radixt.Tree
+	u       radixt.Tree
+	resolve func([]byte, uint, uint) uint
+	e       evident.Tree
+}{
+	{t: nil, u: nil, resolve: nil, e: nil},
+	{t: sapling.New(), u: nil, resolve: nil, e: nil},
+	{t: headers, u: nil, resolve: nil, e: evident.New(headers)},
+	{t: nil, u: others, resolve: nil, e: evident.New(others)},
+	{t: headers, u: headers, resolve: nil, e: evident.New(headers)},
+	{
+		t:       headers,
+		u:       others,
+		resolve: nil,
+		e: evident.Tree{
+			"|": {
+				"auth|10": {
+					"or|2": {
+						"ity|1": nil,
+					},
+				},
+				"content-|": {
+					"length|3": nil,
+					"type|4":   nil,
+				},
+				"x-request-id|50": nil,
+			},
+		},
+	},
+	{
+		t:       headers,
+		u:       others,
+		resolve: sum,
+		e: evident.Tree{
+			"|": {
+				"auth|10": {
+					"or|22": {
+						"ity|1": nil,
+					},
+				},
+				"content-|": {
+					"length|3": nil,
+					"type|44":  nil,
+				},
+				"x-request-id|50": nil,
+			},
+		},
+	},
+	{
+		t:       withBlankKey,
+		u:       others,
+		resolve: sum,
+		e: evident.Tree{
+			"|100": {
+				"auth|10": {
+					"or|220": nil,
+				},
+				"content-type|40": nil,
+				"x-request-id|50": nil,
+			},
+		},
+	},
+}
+
+const testUnionError = "Union Test %d: got\n\n%v\n\nwhich is not equal to" +
+	"\n\n%v\n\n(but should be equal)"
+
+const testCombineContractError = "%s Test %d: got that the resulting tree " +
+	"violates the contract: %v"
+
+func TestUnion(t *testing.T) {
+	for i, tt := range unionTests {
+		result := sapling.Union(tt.t, tt.u, tt.resolve)
+		if !tt.e.Eq(result) {
+			t.Errorf(testUnionError, i, evident.New(result), tt.e)
+		}
+
+		if err := radixt.Validate(result); err != nil {
+			t.Errorf(testCombineContractError, "Union", i, err)
+		}
+	}
+}
+
+const testUnionResolveError = "Union Resolve Test: got %q (should be %q)"
+
+func TestUnionResolve(t *testing.T) {
+	keys := ""
+	sapling.Union(headers, others, func(s []byte, v, w uint) uint {
+		keys += string(s) + ";"
+		return v
+	})
+
+	if keys != "author;content-type;" {
+		t.Errorf(testUnionResolveError, keys, "author;content-type;")
+	}
+}
+
+var intersectionTests = []struct {
+	t radixt.Tree
+	u radixt.Tree
+	e evident.Tree
+}{
+	{t: nil, u: nil, e: nil},
+	{t: headers, u: nil, e: nil},
+	{t: nil, u: others, e: nil},
+	{t: headers, u: headers, e: evident.New(headers)},
+	{
+		t: headers,
+		u: others,
+		e: evident.Tree{
+			"|": {
+				"author|2":       nil,
+				"content-type|4": nil,
+			},
+		},
+	},
+	{
+		t: others,
+		u: headers,
+		e: evident.Tree{
+			"|": {
+				"author|20":       nil,
+				"content-type|40": nil,
+			},
+		},
+	},
+	{t: withBlankKey, u: others, e: evident.Tree{"author|200": nil}},
+	{
+		t: withBlankKey,
+		u: sapling.New("", "a"),
+		e: evident.Tree{"|100": nil},
+	},
+}
+
+const testIntersectionError = "Intersection Test %d: got\n\n%v\n\nwhich is " +
+	"not equal to\n\n%v\n\n(but should be equal)"
+
+func TestIntersection(t *testing.T) {
+	for i, tt := range intersectionTests {
+		result := sapling.Intersection(tt.t, tt.u)
+		if !tt.e.Eq(result) {
+			t.Errorf(
+				testIntersectionError,
+				i,
+				evident.New(result),
+				tt.e,
+			)
+		}
+
+		if err := radixt.Validate(result); err != nil {
+			t.Errorf(
+				testCombineContractError,
+				"Intersection",
+				i,
+				err,
+			)
+		}
+	}
+}
+
+var differenceTests = []struct {
+	t radixt.Tree
+	u radixt.Tree
+	e evident.Tree
+}{
+	{t: nil, u: nil, e: nil},
+	{t: headers, u: nil, e: evident.New(headers)},
+	{t: nil, u: others, e: nil},
+	{t: headers, u: headers, e: nil},
+	{
+		t: headers,
+		u: others,
+		e: evident.Tree{
+			"|": {
+				"authority|1":      nil,
+				"content-length|3": nil,
+			},
+		},
+	},
+	{
+		t: others,
+		u: headers,
+		e: evident.Tree{
+			"|": {
+				"auth|10":         nil,
+				"x-request-id|50": nil,
+			},
+		},
+	},
+	{t: withBlankKey, u: others, e: evident.Tree{"|100": nil}},
+	{
+		t: withBlankKey,
+		u: sapling.New(""),
+		e: evident.Tree{"author|200": nil},
+	},
+}
+
+const testDifferenceError = "Difference Test %d: got\n\n%v\n\nwhich is not " +
+	"equal to\n\n%v\n\n(but should be equal)"
+
+func TestDifference(t *testing.T) {
+	for i, tt := range differenceTests {
+		result := sapling.Difference(tt.t, tt.u)
+		if !tt.e.Eq(result) {
+			t.Errorf(
+				testDifferenceError,
+				i,
+				evident.New(result),
+				tt.e,
+			)
+		}
+
+		if err := radixt.Validate(result); err != nil {
+			t.Errorf(testCombineContractError, "Difference", i, err)
+		}
+	}
+}
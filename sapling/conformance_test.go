@@ -0,0 +1,24 @@
+package sapling_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/sapling"
+	"github.com/alex-ilchukov/radixt/treetest"
+)
+
+func TestConformance(t *testing.T) {
+	treetest.Conformance(t, func(t radixt.Tree) (radixt.Tree, error) {
+		result := sapling.New()
+		keys.Each(t, func(key []byte, v uint) bool {
+			result.Grow(string(key), v)
+			return false
+		})
+
+		// Grown sapling trees do not always serve contract line 4, which
+		// the suite validates, so the tree is renumbered with union.
+		return sapling.Union(result, nil, nil), nil
+	})
+}
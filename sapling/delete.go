@@ -0,0 +1,128 @@
+package sapling
+
+// Delete removes string s with its value from the tree and returns if the tree
+// had the string with a value. See [Tree.Clear] on how the tree is restored
+// after the removal.
+func (t *Tree) Delete(s string) bool {
+	if t.Size() == 0 {
+		return false
+	}
+
+	found, n, _, npos := t.find(s)
+	if !found || t.within(n, npos) {
+		return false
+	}
+
+	return t.Clear(n)
+}
+
+// Clear drops value of node n and returns if the node had a value. Node n
+// is kept only if it is still required: a childless node without value is
+// removed, and a node without value and with only child is merged with the
+// child. The same applies to parent of the removed node. If the value is
+// dropped, the method renumbers nodes in depth-first order, so node indices go
+// sequentially from zero and any child's index is greater than index of its
+// parent.
+func (t *Tree) Clear(n uint) bool {
+	if n >= t.Size() || !t.nodes[n].hasValue {
+		return false
+	}
+
+	t.nodes[n].value = 0
+	t.nodes[n].hasValue = false
+
+	switch len(t.nodes[n].children) {
+	case 0:
+		if n == 0 {
+			t.nodes = nil
+			return true
+		}
+
+		p := t.parent(n)
+		t.unlink(p, n)
+		t.squeeze(p)
+
+	case 1:
+		t.squeeze(n)
+	}
+
+	t.renumber()
+
+	return true
+}
+
+// parent returns index of parent of non-root node n.
+func (t *Tree) parent(n uint) uint {
+	for p, no := range t.nodes {
+		for _, c := range no.children {
+			if c == n {
+				return uint(p)
+			}
+		}
+	}
+
+	panic("node without parent")
+}
+
+// unlink removes node c from list of children of node p.
+func (t *Tree) unlink(p, c uint) {
+	children := t.nodes[p].children
+	for i, d := range children {
+		if d == c {
+			rest := children[i+1:]
+			t.nodes[p].children = append(children[:i:i], rest...)
+			return
+		}
+	}
+}
+
+// squeeze merges node n with its child, if the node has no value and has only
+// child. The child stays in the nodes slice, but becomes unreachable.
+func (t *Tree) squeeze(n uint) {
+	no := t.nodes[n]
+	if no.hasValue || len(no.children) != 1 {
+		return
+	}
+
+	c := t.nodes[no.children[0]]
+	c.chunk = no.chunk + c.chunk
+	t.nodes[n] = c
+}
+
+// renumber drops unreachable nodes and renumbers the rest in depth-first
+// order.
+func (t *Tree) renumber() {
+	nodes := make([]node, 0, len(t.nodes))
+	indices := []uint{0}
+	for len(indices) > 0 {
+		l := len(indices) - 1
+		no := t.nodes[indices[l]]
+		indices = indices[:l]
+		for i := len(no.children) - 1; i >= 0; i-- {
+			indices = append(indices, no.children[i])
+		}
+
+		nodes = append(nodes, no)
+	}
+
+	// In depth-first order the first child of a node goes just after the
+	// node, and the next child goes after all descendants of the previous
+	// one.
+	var number func(n uint) uint
+	number = func(n uint) uint {
+		no := &nodes[n]
+		children := make([]uint, len(no.children))
+		c := n + 1
+		for i := range children {
+			children[i] = c
+			c = number(c)
+		}
+
+		no.children = children
+
+		return c
+	}
+
+	number(0)
+	t.nodes = nodes
+}
@@ -0,0 +1,253 @@
+package sapling_test
+
+import (
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+func sample() *sapling.Tree {
+	return sapling.New(
+		"authority",
+		"authorization",
+		"author",
+		"authentication",
+		"auth",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	)
+}
+
+var treeDeleteTests = []struct {
+	tree   *sapling.Tree
+	s      string
+	result bool
+	e      evident.Tree
+}{
+	{tree: nil, s: "", result: false, e: nil},
+	{tree: sapling.New(), s: "", result: false, e: nil},
+	{tree: sapling.New("auth"), s: "", result: false, e: evident.New(
+		sapling.New("auth"),
+	)},
+	{tree: sapling.New("auth"), s: "auth", result: true, e: nil},
+	{tree: sapling.New(""), s: "", result: true, e: nil},
+	{tree: sample(), s: "aut", result: false, e: evident.New(sample())},
+	{tree: sample(), s: "authori", result: false, e: evident.New(sample())},
+	{tree: sample(), s: "content", result: false, e: evident.New(sample())},
+	{tree: sample(), s: "authors", result: false, e: evident.New(sample())},
+	{
+		tree:   sample(),
+		s:      "authority",
+		result: true,
+		e: evident.Tree{
+			"|": {
+				"auth|4": {
+					"entication|3": nil,
+					"or|2": {
+						"ization|1": nil,
+					},
+				},
+				"content-|": {
+					"disposition|7": nil,
+					"length|6":      nil,
+					"type|5":        nil,
+				},
+			},
+		},
+	},
+	{
+		tree:   sample(),
+		s:      "author",
+		result: true,
+		e: evident.Tree{
+			"|": {
+				"auth|4": {
+					"entication|3": nil,
+					"ori|": {
+						"ty|0":     nil,
+						"zation|1": nil,
+					},
+				},
+				"content-|": {
+					"disposition|7": nil,
+					"length|6":      nil,
+					"type|5":        nil,
+				},
+			},
+		},
+	},
+	{
+		tree:   sample(),
+		s:      "authentication",
+		result: true,
+		e: evident.Tree{
+			"|": {
+				"auth|4": {
+					"or|2": {
+						"i|": {
+							"ty|0":     nil,
+							"zation|1": nil,
+						},
+					},
+				},
+				"content-|": {
+					"disposition|7": nil,
+					"length|6":      nil,
+					"type|5":        nil,
+				},
+			},
+		},
+	},
+	{
+		tree:   sapling.New("auth", "content"),
+		s:      "content",
+		result: true,
+		e:      evident.Tree{"auth|0": nil},
+	},
+	{
+		tree:   sapling.New("", "auth"),
+		s:      "",
+		result: true,
+		e:      evident.Tree{"auth|1": nil},
+	},
+	{
+		tree:   sapling.New("auth", "author", "authority"),
+		s:      "author",
+		result: true,
+		e: evident.Tree{
+			"auth|0": {
+				"ority|2": nil,
+			},
+		},
+	},
+}
+
+const testTreeDeleteError = "Tree Delete Test %d: got %t for deletion of " +
+	"'%s' (should be %t) and the resulting tree\n\n%v\n\nwhich is not " +
+	"equal to\n\n%v\n\n(but should be equal)"
+
+const testTreeDeleteContractError = "Tree Delete Test %d: got that the " +
+	"resulting tree violates the contract: %v"
+
+func TestTreeDelete(t *testing.T) {
+	for i, tt := range treeDeleteTests {
+		result := tt.tree.Delete(tt.s)
+		if result != tt.result || !tt.e.Eq(tt.tree) {
+			t.Errorf(
+				testTreeDeleteError,
+				i,
+				result,
+				tt.s,
+				tt.result,
+				evident.New(tt.tree),
+				tt.e,
+			)
+		}
+
+		if !result {
+			continue
+		}
+
+		if err := radixt.Validate(tt.tree); err != nil {
+			t.Errorf(testTreeDeleteContractError, i, err)
+		}
+	}
+}
+
+var treeClearTests = []struct {
+	tree   *sapling.Tree
+	n      uint
+	result bool
+	size   uint
+}{
+	{tree: nil, n: 0, result: false, size: 0},
+	{tree: sapling.New(), n: 0, result: false, size: 0},
+	{tree: sapling.New("auth"), n: 1, result: false, size: 1},
+	{tree: sapling.New("auth"), n: 0, result: true, size: 0},
+	{tree: sample(), n: 0, result: false, size: 11},
+	{tree: sample(), n: 3, result: false, size: 11},
+	{tree: sample(), n: 1, result: true, size: 9},
+	{tree: sample(), n: 4, result: true, size: 10},
+	{tree: sample(), n: 6, result: true, size: 11},
+	{tree: sample(), n: 10, result: true, size: 10},
+}
+
+const testTreeClearError = "Tree Clear Test %d: got %t for clearing of node " +
+	"%d and %d for size of the resulting tree (should be %t and %d)"
+
+func TestTreeClear(t *testing.T) {
+	for i, tt := range treeClearTests {
+		result := tt.tree.Clear(tt.n)
+		size := tt.tree.Size()
+		if result != tt.result || size != tt.size {
+			t.Errorf(
+				testTreeClearError,
+				i,
+				result,
+				tt.n,
+				size,
+				tt.result,
+				tt.size,
+			)
+		}
+
+		if !result {
+			continue
+		}
+
+		if err := radixt.Validate(tt.tree); err != nil {
+			t.Errorf(testTreeDeleteContractError, i, err)
+		}
+	}
+}
+
+const (
+	testTreeDeleteAllError = "Tree Delete All Test: got false for " +
+		"deletion of '%s' (should be true)"
+	testTreeDeleteAllTreeError = "Tree Delete All Test: got wrong tree " +
+		"after deletion of '%s'"
+	testTreeDeleteAllContractError = "Tree Delete All Test: got that the " +
+		"resulting tree violates the contract: %v"
+	testTreeDeleteAllSizeError = "Tree Delete All Test: got %d for size " +
+		"of the resulting tree (should be 0)"
+)
+
+func TestTreeDeleteAll(t *testing.T) {
+	strings := []string{
+		"authority",
+		"authorization",
+		"author",
+		"authentication",
+		"auth",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	}
+
+	tree := sample()
+	for i, s := range strings {
+		if !tree.Delete(s) {
+			t.Errorf(testTreeDeleteAllError, s)
+		}
+
+		rest := sapling.New()
+		for j := i + 1; j < len(strings); j++ {
+			rest.Grow(strings[j], uint(j))
+		}
+
+		if !evident.New(rest).Eq(tree) {
+			t.Errorf(testTreeDeleteAllTreeError, s)
+		}
+
+		if err := radixt.Validate(tree); err != nil {
+			t.Errorf(testTreeDeleteAllContractError, err)
+		}
+	}
+
+	if size := tree.Size(); size != 0 {
+		t.Errorf(testTreeDeleteAllSizeError, size)
+	}
+}
@@ -1,6 +1,8 @@
 // Package sapling contains an implementation of radix tree accordingly
 // to interface in the parent radixt package. It also provides a ways to _grow_
-// a tree, adding new strings and values into it.
+// a tree, adding new strings and values into it, and to remove strings from
+// the tree. Union, intersection and difference of any two trees can be built as
+// new sapling trees, walking both trees in lockstep chunk by chunk.
 //
 // The implementation is aimed to cover all cases of input data and does not
 // care much of consumed memory. The package also provides factory methods to
@@ -11,4 +13,12 @@
 // The tree struct is exported outside, and the implementation supports nil
 // pointers to the struct. As the implementation is _dynamic_, it does _not_
 // guarantee safety over concurrent reading and writing.
+//
+// Indices of nodes follow order of their creation. As growing a tree can
+// split a node and put its former children under new node, a child can have
+// lesser index than its parent, so sapling trees do not always serve contract
+// line 4 of [radixt.Tree] (see [radixt.Validate]). Implementations, created
+// from sapling trees, renumber nodes and serve the contract. Removal of
+// strings renumbers nodes of the tree itself, so it serves the contract until
+// it grows again.
 package sapling
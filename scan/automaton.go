@@ -0,0 +1,236 @@
+package scan
+
+import (
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/internal/order"
+	"github.com/alex-ilchukov/radixt/lookup"
+	"github.com/alex-ilchukov/radixt/null"
+)
+
+// Match is an occurrence of a key in text: the key takes bytes of the text
+// from offset Start (inclusive) to offset End (exclusive) and has value Value
+// in the tree.
+type Match struct {
+	Start int
+	End   int
+	Value uint
+}
+
+// Automaton is Aho-Corasick automaton, built on top of a radix tree. Zero
+// state is the initial one, the position before chunk of the root. Any other
+// state s is the position after (s - bases[n]) bytes of chunk of node n, where
+// n is nodes[s]. Chunks, values and children of the nodes are read through
+// the tree.
+type Automaton struct {
+	t radixt.Tree
+	s lookup.Switcher
+
+	// Information on nodes, indexed by node indices: length of keys and
+	// bases of states.
+	depths []int
+	bases  []uint
+
+	// Information on states, indexed by the states: nodes, failure links
+	// and output links to the longest proper suffixes, which are keys with
+	// values.
+	nodes []uint
+	fails []uint
+	links []uint
+}
+
+// New builds automaton for the keys with values of the provided tree t and
+// returns a pointer on the automaton. Nil values of t are supported and
+// interpreted as empty tree. The empty key is never reported as found. The
+// automaton reads chunks and values of the tree, but the states and links are
+// built once, so the tree must not be changed, while the automaton is in use.
+func New(t radixt.Tree) *Automaton {
+	if t == nil {
+		t = null.Tree
+	}
+
+	size := t.Size()
+	a := &Automaton{
+		t:      t,
+		depths: make([]int, size),
+		bases:  make([]uint, size),
+	}
+
+	a.s, _ = t.(lookup.Switcher)
+
+	states := uint(1)
+	for n := uint(0); n < size; n++ {
+		a.bases[n] = states - 1
+		states += uint(len(t.Chunk(n)))
+	}
+
+	a.nodes = make([]uint, states)
+	for n := uint(0); n < size; n++ {
+		base := a.bases[n]
+		for i := 1; i <= len(t.Chunk(n)); i++ {
+			a.nodes[base+uint(i)] = n
+		}
+	}
+
+	a.fillDepths()
+	a.fill(states)
+
+	return a
+}
+
+// fillDepths fills lengths of keys of the nodes, walking down from the root,
+// as some implementations do not order nodes, so that parents go first.
+func (a *Automaton) fillDepths() {
+	if len(a.bases) == 0 {
+		return
+	}
+
+	a.depths[0] = len(a.t.Chunk(0))
+	stack := []uint{0}
+	for len(stack) > 0 {
+		l := len(stack) - 1
+		n := stack[l]
+		stack = stack[:l]
+		a.t.EachChild(n, func(c uint) bool {
+			a.depths[c] = a.depths[n] + len(a.t.Chunk(c))
+			stack = append(stack, c)
+			return false
+		})
+	}
+}
+
+// fill fills failure and output links of the states in breadth-first order,
+// so links of every state are known before any deeper state is visited.
+func (a *Automaton) fill(states uint) {
+	a.fails = make([]uint, states)
+	a.links = make([]uint, states)
+	queue := make([]uint, 1, states)
+	for i := 0; i < len(queue); i++ {
+		s := queue[i]
+		a.each(s, func(b byte, u uint) {
+			f := uint(0)
+			if s != 0 {
+				f = a.next(a.fails[s], b)
+			}
+
+			a.fails[u] = f
+			a.links[u] = a.links[f]
+			if a.terminal(f) {
+				a.links[u] = f
+			}
+
+			queue = append(queue, u)
+		})
+	}
+}
+
+// each calls function e for every transition from state s with byte b of the
+// transition and state u, which the transition leads to.
+func (a *Automaton) each(s uint, e func(b byte, u uint)) {
+	if len(a.bases) == 0 {
+		return
+	}
+
+	n := a.nodes[s]
+	chunk := a.t.Chunk(n)
+	if i := s - a.bases[n]; i < uint(len(chunk)) {
+		e(chunk[i], s+1)
+		return
+	}
+
+	for _, c := range order.Children(a.t, n, nil) {
+		e(a.t.Chunk(c)[0], a.bases[c]+1)
+	}
+}
+
+// step returns state u, which transition with byte b from state s leads to,
+// with boolean true flag, if there is such a transition, or default values
+// otherwise.
+func (a *Automaton) step(s uint, b byte) (u uint, ok bool) {
+	if len(a.bases) == 0 {
+		return
+	}
+
+	n := a.nodes[s]
+	chunk := a.t.Chunk(n)
+	if i := s - a.bases[n]; i < uint(len(chunk)) {
+		if chunk[i] == b {
+			u, ok = s+1, true
+		}
+
+		return
+	}
+
+	if a.s != nil {
+		if c, _, found := a.s.Switch(n, b); found {
+			u, ok = a.bases[c]+1, true
+		}
+
+		return
+	}
+
+	a.t.EachChild(n, func(c uint) bool {
+		if a.t.Chunk(c)[0] == b {
+			u, ok = a.bases[c]+1, true
+		}
+
+		return ok
+	})
+
+	return
+}
+
+// next returns the state after state s and byte b, following failure links,
+// if there is no transition with the byte.
+func (a *Automaton) next(s uint, b byte) uint {
+	for {
+		if u, ok := a.step(s, b); ok {
+			return u
+		}
+
+		if s == 0 {
+			return 0
+		}
+
+		s = a.fails[s]
+	}
+}
+
+// terminal returns if state s is the end of a non-empty key with value.
+func (a *Automaton) terminal(s uint) bool {
+	if s == 0 {
+		return false
+	}
+
+	n := a.nodes[s]
+	if s != a.bases[n]+uint(len(a.t.Chunk(n))) {
+		return false
+	}
+
+	_, has := a.t.Value(n)
+	return has
+}
+
+// report calls function e for every key, which ends in state s at offset end
+// of text, starting from the longest one, until the function returns boolean
+// truth. It returns if the function has returned truth.
+func (a *Automaton) report(s uint, end int, e func(m Match) bool) bool {
+	if !a.terminal(s) {
+		s = a.links[s]
+	}
+
+	for ; s != 0; s = a.links[s] {
+		n := a.nodes[s]
+		start := end - a.depths[n]
+		v, _ := a.t.Value(n)
+		if e(Match{Start: start, End: end, Value: v}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Tree returns radix tree.
+func (a *Automaton) Tree() radixt.Tree {
+	return a.t
+}
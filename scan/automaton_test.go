@@ -0,0 +1,191 @@
+package scan_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/sapling"
+	"github.com/alex-ilchukov/radixt/scan"
+)
+
+var (
+	classic = sapling.New("he", "she", "his", "hers")
+
+	rooted = sapling.NewFromSV(
+		sapling.SV{S: "", V: 10},
+		sapling.SV{S: "ab", V: 11},
+		sapling.SV{S: "b", V: 12},
+	)
+
+	prefixed = sapling.New("abc", "abcd", "abd")
+)
+
+var allTests = []struct {
+	t      radixt.Tree
+	text   string
+	result []scan.Match
+}{
+	{t: nil, text: "ushers", result: nil},
+	{t: sapling.New(), text: "ushers", result: nil},
+	{t: classic, text: "", result: nil},
+	{
+		t:    classic,
+		text: "ushers",
+		result: []scan.Match{
+			{Start: 1, End: 4, Value: 1},
+			{Start: 2, End: 4, Value: 0},
+			{Start: 2, End: 6, Value: 3},
+		},
+	},
+	{
+		t:    generic.New(classic),
+		text: "ahishers",
+		result: []scan.Match{
+			{Start: 1, End: 4, Value: 2},
+			{Start: 3, End: 6, Value: 1},
+			{Start: 4, End: 6, Value: 0},
+			{Start: 4, End: 8, Value: 3},
+		},
+	},
+	{
+		t:    rooted,
+		text: "abab",
+		result: []scan.Match{
+			{Start: 0, End: 2, Value: 11},
+			{Start: 1, End: 2, Value: 12},
+			{Start: 2, End: 4, Value: 11},
+			{Start: 3, End: 4, Value: 12},
+		},
+	},
+	{
+		t:    str3.MustCreate(prefixed),
+		text: "xabcabdabcd",
+		result: []scan.Match{
+			{Start: 1, End: 4, Value: 0},
+			{Start: 4, End: 7, Value: 2},
+			{Start: 7, End: 10, Value: 0},
+			{Start: 7, End: 11, Value: 1},
+		},
+	},
+	{
+		t:    structg.MustCreate[uint32](sapling.New("aaa", "a")),
+		text: "aaaa",
+		result: []scan.Match{
+			{Start: 0, End: 1, Value: 1},
+			{Start: 1, End: 2, Value: 1},
+			{Start: 0, End: 3, Value: 0},
+			{Start: 2, End: 3, Value: 1},
+			{Start: 1, End: 4, Value: 0},
+			{Start: 3, End: 4, Value: 1},
+		},
+	},
+}
+
+const testAllError = "All Test %d: for text %q got %v (should be %v)"
+
+func TestAll(t *testing.T) {
+	for i, tt := range allTests {
+		result := scan.New(tt.t).All([]byte(tt.text))
+		if !reflect.DeepEqual(result, tt.result) {
+			t.Errorf(testAllError, i, tt.text, result, tt.result)
+		}
+	}
+}
+
+const testEachStopError = "Each Stop Test: got %d calls (should be 2)"
+
+func TestEachStop(t *testing.T) {
+	calls := 0
+	scan.New(classic).Each([]byte("ushers ushers"), func(scan.Match) bool {
+		calls++
+		return calls == 2
+	})
+
+	if calls != 2 {
+		t.Errorf(testEachStopError, calls)
+	}
+}
+
+const testTreeError = "Tree Test: got %v (should be %v)"
+
+func TestTree(t *testing.T) {
+	if result := scan.New(classic).Tree(); result != classic {
+		t.Errorf(testTreeError, result, classic)
+	}
+}
+
+func random(r *rand.Rand, max int) string {
+	b := make([]byte, r.Intn(max)+1)
+	for i := range b {
+		b[i] = "abc"[r.Intn(3)]
+	}
+
+	return string(b)
+}
+
+// naive finds all the occurrences of all the keys in text by brute force.
+func naive(keys []string, text string) (matches []scan.Match) {
+	for i := range keys {
+		for start := 0; start < len(text); start++ {
+			if strings.HasPrefix(text[start:], keys[i]) {
+				matches = append(matches, scan.Match{
+					Start: start,
+					End:   start + len(keys[i]),
+					Value: uint(i),
+				})
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		return a.End < b.End || (a.End == b.End && a.Start < b.Start)
+	})
+
+	return
+}
+
+const testAllRandomError = "All Random Test %d: for keys %q and text %q " +
+	"got %v (should be %v)"
+
+// TestAllRandom compares the occurrences, found in random texts, with the
+// ones, found by brute force.
+func TestAllRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		seen := map[string]bool{}
+		var keys []string
+		for j := r.Intn(8) + 1; j > 0; j-- {
+			if key := random(r, 5); !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+
+		text := random(r, 40)
+		e := naive(keys, text)
+		for _, tree := range []radixt.Tree{
+			sapling.New(keys...),
+			generic.New(sapling.New(keys...)),
+		} {
+			result := scan.New(tree).All([]byte(text))
+			if !reflect.DeepEqual(result, e) {
+				t.Errorf(
+					testAllRandomError,
+					i,
+					keys,
+					text,
+					result,
+					e,
+				)
+			}
+		}
+	}
+}
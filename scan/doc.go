@@ -0,0 +1,16 @@
+// Package scan provides multi-pattern search of keys of radix trees in text:
+// it finds all the occurrences of all the keys in one linear pass over the
+// text with Aho-Corasick algorithm. The search works with any implementation
+// of [radixt.Tree] interface.
+//
+// Radix trees are tries with chunks, so states of the automaton are positions
+// within the chunks: a state is a node with amount of bytes of the node's
+// chunk, which are already matched. The states are numbered node by node in
+// order of node indices, so the automaton keeps per-node information and
+// failure and output links of the states in dense slices only. Chunks, values
+// and children of the nodes are not copied: the tree stays the only source of
+// them, and the search reads them through the tree.
+//
+// The automaton is built once and never changed after that, so it is safe to
+// use by multiple goroutines concurrently, as long as the tree is.
+package scan
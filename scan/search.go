@@ -0,0 +1,58 @@
+package scan
+
+import "io"
+
+// bufferLen is length of buffer to read text from [io.Reader].
+const bufferLen = 4096
+
+// Each calls function e for every occurrence of every key in text, until the
+// function returns boolean truth. The occurrences are reported in ascending
+// order of their ends, and the longest occurrence goes first for the same
+// end.
+func (a *Automaton) Each(text []byte, e func(m Match) bool) {
+	s := uint(0)
+	for i, b := range text {
+		s = a.next(s, b)
+		if a.report(s, i+1, e) {
+			return
+		}
+	}
+}
+
+// All returns all the occurrences of all the keys in text in order of
+// [Automaton.Each].
+func (a *Automaton) All(text []byte) (matches []Match) {
+	a.Each(text, func(m Match) bool {
+		matches = append(matches, m)
+		return false
+	})
+
+	return
+}
+
+// EachIn is like [Automaton.Each], but reads text from r until end of file.
+// Offsets of the occurrences are counted from the first read byte. It returns
+// nil, if the end of file is reached or the function returns boolean truth,
+// or error of reading otherwise.
+func (a *Automaton) EachIn(r io.Reader, e func(m Match) bool) error {
+	var buf [bufferLen]byte
+	s := uint(0)
+	offset := 0
+	for {
+		l, err := r.Read(buf[:])
+		for i, b := range buf[:l] {
+			s = a.next(s, b)
+			if a.report(s, offset+i+1, e) {
+				return nil
+			}
+		}
+
+		offset += l
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+	}
+}
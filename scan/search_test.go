@@ -0,0 +1,101 @@
+package scan_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/alex-ilchukov/radixt/scan"
+)
+
+var errorRead = errors.New("read error")
+
+// long is text, where occurrences of the classic keys cross boundaries of
+// buffers of reading.
+var long = strings.Repeat("ushers ", 2000)
+
+var eachInTests = []struct {
+	name   string
+	r      io.Reader
+	text   string
+	result error
+}{
+	{
+		name:   "strings",
+		r:      strings.NewReader(long),
+		text:   long,
+		result: nil,
+	},
+	{
+		name:   "one byte",
+		r:      iotest.OneByteReader(strings.NewReader("ahishers")),
+		text:   "ahishers",
+		result: nil,
+	},
+	{
+		name:   "data with EOF",
+		r:      iotest.DataErrReader(strings.NewReader("ushers")),
+		text:   "ushers",
+		result: nil,
+	},
+	{
+		name: "error",
+		r: io.MultiReader(
+			iotest.OneByteReader(strings.NewReader("ushe")),
+			iotest.ErrReader(errorRead),
+		),
+		text:   "ushe",
+		result: errorRead,
+	},
+	{name: "empty", r: strings.NewReader(""), text: "", result: nil},
+}
+
+const testEachInError = "EachIn Test %s: got %v and error %v (should be %v " +
+	"and %v)"
+
+func TestEachIn(t *testing.T) {
+	a := scan.New(classic)
+	for _, tt := range eachInTests {
+		var result []scan.Match
+		err := a.EachIn(tt.r, func(m scan.Match) bool {
+			result = append(result, m)
+			return false
+		})
+
+		e := a.All([]byte(tt.text))
+		if !reflect.DeepEqual(result, e) || err != tt.result {
+			t.Errorf(
+				testEachInError,
+				tt.name,
+				result,
+				err,
+				e,
+				tt.result,
+			)
+		}
+	}
+}
+
+const testEachInStopError = "EachIn Stop Test: got %d calls and error %v " +
+	"(should be 3 and nil)"
+
+func TestEachInStop(t *testing.T) {
+	calls := 0
+	err := scan.New(classic).EachIn(
+		io.MultiReader(
+			strings.NewReader("ushers"),
+			iotest.ErrReader(errorRead),
+		),
+		func(scan.Match) bool {
+			calls++
+			return calls == 3
+		},
+	)
+
+	if calls != 3 || err != nil {
+		t.Errorf(testEachInStopError, calls, err)
+	}
+}
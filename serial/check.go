@@ -0,0 +1,53 @@
+package serial
+
+import (
+	"fmt"
+
+	"github.com/alex-ilchukov/radixt/internal/contract"
+)
+
+// CheckChildren checks structure of decoded tree with the provided amount of
+// nodes. Function r returns low and high indices of children of a node, and
+// function first returns first byte of chunk of a node with boolean true
+// flag, if the chunk is not empty. The function checks, that children of all
+// the nodes partition all the non-root nodes in ascending order, so every
+// node, but the root, has the only parent with lesser index, and that first
+// bytes of chunks of every node's children are present and strictly ascend.
+// It returns nil, if the checks pass, or error, wrapping [ErrorCorrupted],
+// otherwise.
+func CheckChildren(
+	size uint,
+	r func(n uint) (low, high uint),
+	first func(n uint) (b byte, ok bool),
+) error {
+	if n, reason := contract.Children(size, r, first); reason != "" {
+		return Corrupted(n, reason)
+	}
+
+	return nil
+}
+
+// CheckParents checks parents of nodes of decoded tree with the provided
+// amount of nodes, which has passed [CheckChildren] check. Function r returns
+// low and high indices of children of a node, and function parent returns
+// parent of a node with boolean true flag, if the node is not root. The
+// function checks, that the root has no parent and parent of every child is
+// the node with the child. It returns nil, if the checks pass, or error,
+// wrapping [ErrorCorrupted], otherwise.
+func CheckParents(
+	size uint,
+	r func(n uint) (low, high uint),
+	parent func(n uint) (p uint, ok bool),
+) error {
+	if n, reason := contract.Parents(size, r, parent); reason != "" {
+		return Corrupted(n, reason)
+	}
+
+	return nil
+}
+
+// Corrupted returns error on node n with the provided reason, wrapping
+// [ErrorCorrupted].
+func Corrupted(n uint, reason string) error {
+	return fmt.Errorf("%w: node %d: %s", ErrorCorrupted, n, reason)
+}
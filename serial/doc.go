@@ -0,0 +1,22 @@
+// Package serial is umbrella package for binary serialization of radix tree
+// implementations. It serves as a storage for common machinery, used by the
+// implementations, which support the serialization, and for errors, which
+// are returned on decoding of corrupted input.
+//
+// All the implementations share the same frame format. All integers are
+// little-endian.
+//
+//   - magic bytes "RDXT" (4 bytes);
+//   - version of the format (1 byte);
+//   - kind of implementation (1 byte);
+//   - width of serialized node in bytes (1 byte);
+//   - flags, specific to implementation (1 byte);
+//   - header, specific to implementation (8 bytes);
+//   - length of string of chunks combined (4 bytes);
+//   - length of string of first bytes of chunks (4 bytes);
+//   - amount of nodes (4 bytes);
+//   - string of chunks combined;
+//   - string of first bytes of chunks;
+//   - nodes, every one is of the width above;
+//   - CRC-32 checksum (IEEE polynomial) of all the preceding bytes (4 bytes).
+package serial
@@ -0,0 +1,31 @@
+package serial
+
+import "errors"
+
+// ErrorTruncated is returned on decoding, if the input is too short or its
+// length does not match the lengths in the frame.
+var ErrorTruncated = errors.New("serialized tree is truncated")
+
+// ErrorMagic is returned on decoding, if the input does not start with magic
+// bytes.
+var ErrorMagic = errors.New("serialized tree has invalid magic bytes")
+
+// ErrorVersion is returned on decoding, if version of the format is not
+// supported.
+var ErrorVersion = errors.New("serialized tree has unsupported version")
+
+// ErrorChecksum is returned on decoding, if checksum of the input does not
+// match.
+var ErrorChecksum = errors.New("serialized tree has invalid checksum")
+
+// ErrorKind is returned on decoding, if the input is serialized by another
+// implementation.
+var ErrorKind = errors.New("serialized tree is of another kind")
+
+// ErrorWidth is returned on decoding, if width of nodes does not match the
+// implementation.
+var ErrorWidth = errors.New("serialized tree has invalid node width")
+
+// ErrorCorrupted is returned on decoding, if header or node fields of the
+// input are not valid. The error is usually wrapped with details.
+var ErrorCorrupted = errors.New("serialized tree is corrupted")
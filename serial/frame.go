@@ -0,0 +1,158 @@
+package serial
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+)
+
+// Version is the current version of the format.
+const Version = 1
+
+const (
+	magic      = "RDXT"
+	prefixLen  = 28
+	suffixLen  = 4
+	maxLen32   = 1<<32 - 1
+	offVersion = 4
+	offKind    = 5
+	offWidth   = 6
+	offFlags   = 7
+	offHeader  = 8
+	offChunks  = 16
+	offFirsts  = 20
+	offNodes   = 24
+	headerLen  = offChunks - offHeader
+)
+
+// Kind identifies implementation of radix tree in serialized form.
+type Kind byte
+
+// Kinds of implementations, which support the serialization.
+const (
+	KindGeneric Kind = iota + 1
+	KindStructg
+	KindStruct32
+	KindStruct64
+)
+
+// Frame contains all the data of serialized radix tree.
+type Frame struct {
+	Kind   Kind
+	Width  byte
+	Flags  byte
+	Header [headerLen]byte
+	Chunks string
+	Firsts string
+	Nodes  []byte
+}
+
+// Size returns amount of nodes in the frame.
+func (f *Frame) Size() uint {
+	if f.Width == 0 {
+		return 0
+	}
+
+	return uint(len(f.Nodes) / int(f.Width))
+}
+
+// Node returns serialized node n of the frame.
+func (f *Frame) Node(n uint) []byte {
+	w := uint(f.Width)
+	return f.Nodes[n*w : (n+1)*w]
+}
+
+// Encode returns frame f in binary form. It returns [ErrorCorrupted], if
+// lengths of chunks or nodes do not fit the format, or if length of nodes is
+// not multiple of the width.
+func (f *Frame) Encode() ([]byte, error) {
+	size := f.Size()
+	e := f.Width == 0 ||
+		uint(len(f.Nodes)) != size*uint(f.Width) ||
+		uint64(len(f.Chunks)) > maxLen32 ||
+		uint64(len(f.Firsts)) > maxLen32 ||
+		uint64(size) > maxLen32
+
+	if e {
+		return nil, ErrorCorrupted
+	}
+
+	l := prefixLen + len(f.Chunks) + len(f.Firsts) + len(f.Nodes)
+	data := make([]byte, prefixLen, l+suffixLen)
+	copy(data, magic)
+	data[offVersion] = Version
+	data[offKind] = byte(f.Kind)
+	data[offWidth] = f.Width
+	data[offFlags] = f.Flags
+	copy(data[offHeader:], f.Header[:])
+	binary.LittleEndian.PutUint32(data[offChunks:], uint32(len(f.Chunks)))
+	binary.LittleEndian.PutUint32(data[offFirsts:], uint32(len(f.Firsts)))
+	binary.LittleEndian.PutUint32(data[offNodes:], uint32(size))
+	data = append(data, f.Chunks...)
+	data = append(data, f.Firsts...)
+	data = append(data, f.Nodes...)
+	data = binary.LittleEndian.AppendUint32(data, crc32.ChecksumIEEE(data))
+
+	return data, nil
+}
+
+// Decode takes binary form data of a frame with expected kind k and width w
+// of nodes, and returns the decoded frame with nil error. The frame does not
+// share memory with data. It returns [ErrorTruncated], [ErrorMagic],
+// [ErrorVersion], [ErrorChecksum], [ErrorKind] or [ErrorWidth], if the
+// envelope of the frame is invalid. Fields of header and nodes are not
+// validated.
+func Decode(data []byte, k Kind, w byte) (f Frame, err error) {
+	if len(data) < prefixLen+suffixLen {
+		err = ErrorTruncated
+		return
+	}
+
+	switch {
+	case string(data[:len(magic)]) != magic:
+		err = ErrorMagic
+		return
+
+	case data[offVersion] != Version:
+		err = ErrorVersion
+		return
+	}
+
+	chunks := uint64(binary.LittleEndian.Uint32(data[offChunks:]))
+	firsts := uint64(binary.LittleEndian.Uint32(data[offFirsts:]))
+	nodes := uint64(binary.LittleEndian.Uint32(data[offNodes:]))
+	expected := prefixLen + chunks + firsts + nodes*uint64(w) + suffixLen
+	if data[offWidth] == w && uint64(len(data)) != expected {
+		err = ErrorTruncated
+		return
+	}
+
+	body := data[:len(data)-suffixLen]
+	checksum := binary.LittleEndian.Uint32(data[len(body):])
+	switch {
+	case crc32.ChecksumIEEE(body) != checksum:
+		err = ErrorChecksum
+		return
+
+	case Kind(data[offKind]) != k:
+		err = ErrorKind
+		return
+
+	case data[offWidth] != w:
+		err = ErrorWidth
+		return
+	}
+
+	f.Kind = k
+	f.Width = w
+	f.Flags = data[offFlags]
+	copy(f.Header[:], data[offHeader:offChunks])
+
+	rest := body[prefixLen:]
+	f.Chunks = string(rest[:chunks])
+	rest = rest[chunks:]
+	f.Firsts = string(rest[:firsts])
+	rest = rest[firsts:]
+	f.Nodes = append([]byte(nil), rest...)
+
+	return
+}
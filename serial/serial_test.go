@@ -0,0 +1,460 @@
+package serial_test
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/struct32"
+	"github.com/alex-ilchukov/radixt/compact/struct64"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/lookup"
+	"github.com/alex-ilchukov/radixt/sapling"
+	"github.com/alex-ilchukov/radixt/serial"
+)
+
+type marshaler interface {
+	radixt.Tree
+	encoding.BinaryMarshaler
+}
+
+type impl struct {
+	name   string
+	create func(t radixt.Tree) marshaler
+	decode func(data []byte) (radixt.Tree, error)
+}
+
+var impls = []impl{
+	{
+		name: "generic",
+		create: func(t radixt.Tree) marshaler {
+			return generic.New(t)
+		},
+		decode: func(data []byte) (radixt.Tree, error) {
+			return nilOnError(generic.Decode(data))
+		},
+	},
+	{
+		name: "structg[uint32]",
+		create: func(t radixt.Tree) marshaler {
+			return structg.MustCreate[uint32](t)
+		},
+		decode: func(data []byte) (radixt.Tree, error) {
+			return nilOnError(structg.Decode[uint32](data))
+		},
+	},
+	{
+		name: "structg[uint64]",
+		create: func(t radixt.Tree) marshaler {
+			return structg.MustCreate[uint64](t)
+		},
+		decode: func(data []byte) (radixt.Tree, error) {
+			return nilOnError(structg.Decode[uint64](data))
+		},
+	},
+	{
+		name: "structg[uint32] with parents",
+		create: func(t radixt.Tree) marshaler {
+			return must(structg.NewWithParents[uint32](t))
+		},
+		decode: func(data []byte) (radixt.Tree, error) {
+			return nilOnError(structg.Decode[uint32](data))
+		},
+	},
+	{
+		name: "structg[uint64] with parents",
+		create: func(t radixt.Tree) marshaler {
+			return must(structg.NewWithParents[uint64](t))
+		},
+		decode: func(data []byte) (radixt.Tree, error) {
+			return nilOnError(structg.Decode[uint64](data))
+		},
+	},
+	{
+		name: "struct32",
+		create: func(t radixt.Tree) marshaler {
+			return struct32.MustCreate(t)
+		},
+		decode: func(data []byte) (radixt.Tree, error) {
+			return nilOnError(struct32.Decode(data))
+		},
+	},
+	{
+		name: "struct64",
+		create: func(t radixt.Tree) marshaler {
+			return struct64.MustCreate(t)
+		},
+		decode: func(data []byte) (radixt.Tree, error) {
+			return nilOnError(struct64.Decode(data))
+		},
+	},
+}
+
+func must[T marshaler](t T, err error) marshaler {
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+func nilOnError[T radixt.Tree](t T, err error) (radixt.Tree, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+var trees = []radixt.Tree{
+	sapling.New(),
+	sapling.New(""),
+	sapling.New("a"),
+	sapling.New("", "a", "ab"),
+	sapling.New(
+		"authorization",
+		"content-type",
+		"content-length",
+		"content-disposition",
+	),
+	sapling.New(
+		"GET",
+		"POST",
+		"PATCH",
+		"DELETE",
+		"PUT",
+		"OPTIONS",
+		"CONNECT",
+		"HEAD",
+		"TRACE",
+	),
+}
+
+const testRoundTripError = "Round Trip Test %s %d: got %v and error %v " +
+	"(should be %v)"
+
+func TestRoundTrip(t *testing.T) {
+	for _, im := range impls {
+		for i, tree := range trees {
+			data, err := im.create(tree).MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := im.decode(data)
+			if err != nil || !evident.New(result).Eq(tree) {
+				t.Errorf(
+					testRoundTripError,
+					im.name,
+					i,
+					evident.New(result),
+					err,
+					evident.New(tree),
+				)
+			}
+		}
+	}
+}
+
+const testRoundTripWideError = "Round Trip Wide Test: got %v and error %v " +
+	"(should be equal to the original tree)"
+
+// TestRoundTripWide verifies, that generic tree with node of more than 255
+// children survives the round trip.
+func TestRoundTripWide(t *testing.T) {
+	var keys []string
+	for b := 0; b < 256; b++ {
+		keys = append(keys, string([]byte{byte(b)}))
+	}
+
+	tree := sapling.New(keys...)
+	data, err := generic.New(tree).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := generic.Decode(data)
+	if err != nil || !evident.New(result).Eq(tree) {
+		t.Errorf(testRoundTripWideError, result, err)
+	}
+}
+
+const testUnmarshalError = "Unmarshal Test %s: got %v and error %v " +
+	"(should be %v)"
+
+func TestUnmarshal(t *testing.T) {
+	tree := trees[len(trees)-1]
+	for _, im := range impls {
+		data, _ := im.create(tree).MarshalBinary()
+		u := im.create(nil).(encoding.BinaryUnmarshaler)
+
+		err := u.UnmarshalBinary(data)
+		result := u.(radixt.Tree)
+		if err != nil || !evident.New(result).Eq(tree) {
+			t.Errorf(
+				testUnmarshalError,
+				im.name,
+				evident.New(result),
+				err,
+				evident.New(tree),
+			)
+		}
+	}
+}
+
+func seal(data []byte) []byte {
+	body := data[:len(data)-4]
+	checksum := crc32.ChecksumIEEE(body)
+	binary.LittleEndian.PutUint32(data[len(body):], checksum)
+	return data
+}
+
+func clone(data []byte, f func(data []byte)) []byte {
+	result := append([]byte(nil), data...)
+	f(result)
+	return result
+}
+
+var envelopeTests = []struct {
+	name   string
+	mutate func(data []byte) []byte
+	err    error
+}{
+	{
+		name:   "empty",
+		mutate: func(data []byte) []byte { return nil },
+		err:    serial.ErrorTruncated,
+	},
+	{
+		name:   "truncated",
+		mutate: func(data []byte) []byte { return data[:len(data)-1] },
+		err:    serial.ErrorTruncated,
+	},
+	{
+		name: "magic",
+		mutate: func(data []byte) []byte {
+			return clone(data, func(d []byte) { d[0] = 'X' })
+		},
+		err: serial.ErrorMagic,
+	},
+	{
+		name: "version",
+		mutate: func(data []byte) []byte {
+			return clone(data, func(d []byte) {
+				d[4] = serial.Version + 1
+			})
+		},
+		err: serial.ErrorVersion,
+	},
+	{
+		name: "checksum",
+		mutate: func(data []byte) []byte {
+			return clone(data, func(d []byte) { d[len(d)-5] ^= 1 })
+		},
+		err: serial.ErrorChecksum,
+	},
+	{
+		name: "kind",
+		mutate: func(data []byte) []byte {
+			return seal(clone(data, func(d []byte) { d[5] = 0xFF }))
+		},
+		err: serial.ErrorKind,
+	},
+	{
+		name: "width",
+		mutate: func(data []byte) []byte {
+			return seal(clone(data, func(d []byte) { d[6] = 3 }))
+		},
+		err: serial.ErrorWidth,
+	},
+	{
+		name: "flags",
+		mutate: func(data []byte) []byte {
+			return seal(clone(data, func(d []byte) { d[7] = 0x80 }))
+		},
+		err: serial.ErrorCorrupted,
+	},
+	{
+		name: "nodes amount",
+		mutate: func(data []byte) []byte {
+			return seal(clone(data, func(d []byte) { d[24]++ }))
+		},
+		err: serial.ErrorTruncated,
+	},
+}
+
+const testEnvelopeError = "Envelope Test %s %s: got %v and error %v " +
+	"(should be nil and %v)"
+
+func TestEnvelope(t *testing.T) {
+	tree := trees[len(trees)-1]
+	for _, im := range impls {
+		data, _ := im.create(tree).MarshalBinary()
+		for _, tt := range envelopeTests {
+			result, err := im.decode(tt.mutate(data))
+			if result != nil || !errors.Is(err, tt.err) {
+				t.Errorf(
+					testEnvelopeError,
+					im.name,
+					tt.name,
+					result,
+					err,
+					tt.err,
+				)
+			}
+		}
+	}
+}
+
+// children sets amount and the first child of node n in generic tree data.
+func children(data []byte, n int, amount uint16, first uint64) {
+	chunks := int(binary.LittleEndian.Uint32(data[16:]))
+	node := data[28+chunks+n*36:]
+	binary.LittleEndian.PutUint16(node[2:], amount)
+	binary.LittleEndian.PutUint64(node[4:], first)
+}
+
+// loopedTree has nodes 0 (root), 1 ("a"), 2 ("b") and 3 ("c"), the latter
+// is child of node 2 in the original tree.
+var loopedTree = sapling.New("a", "b", "bc")
+
+var loopTests = []struct {
+	name   string
+	mutate func(data []byte)
+}{
+	{
+		name: "self-parented",
+		mutate: func(data []byte) {
+			children(data, 0, 1, 1)
+			children(data, 2, 2, 2)
+		},
+	},
+	{
+		name: "child below parent",
+		mutate: func(data []byte) {
+			children(data, 0, 1, 1)
+			children(data, 2, 0, 0)
+			children(data, 3, 2, 2)
+		},
+	},
+}
+
+const testLoopError = "Loop Test %s: got %v and error %v (should be nil " +
+	"and %v)"
+
+// TestLoop verifies, that generic tree with a node, which is child of itself
+// or of a node with greater index, is not decoded, as walks over parents of
+// such a tree would never end.
+func TestLoop(t *testing.T) {
+	data, err := generic.New(loopedTree).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range loopTests {
+		d := seal(clone(data, tt.mutate))
+		result, err := generic.Decode(d)
+		if result != nil || !errors.Is(err, serial.ErrorCorrupted) {
+			t.Errorf(
+				testLoopError,
+				tt.name,
+				result,
+				err,
+				serial.ErrorCorrupted,
+			)
+		}
+	}
+}
+
+const testCorruptedError = "Corrupted Test %s: byte %d set to %d: got " +
+	"error %v (should be %v)"
+
+// TestCorrupted sets every byte of valid input to various values, fixes the
+// checksum, and verifies, that decoding never panics, and that a decoded tree
+// is consistent and keeps the contract.
+func TestCorrupted(t *testing.T) {
+	for _, im := range impls {
+		for _, tree := range trees {
+			data, _ := im.create(tree).MarshalBinary()
+			for i := 0; i < len(data)-4; i++ {
+				corrupt(t, im, data, i)
+			}
+		}
+	}
+}
+
+var corruptedValues = []byte{0x00, 0x01, 0x02, 0x07, 0x1F, 0x20, 0x40, 0xFF}
+
+var decodingErrors = []error{
+	serial.ErrorCorrupted,
+	serial.ErrorTruncated,
+	serial.ErrorKind,
+	serial.ErrorWidth,
+	serial.ErrorMagic,
+	serial.ErrorVersion,
+}
+
+func corrupt(t *testing.T, im impl, data []byte, i int) {
+	for _, v := range corruptedValues {
+		d := clone(data, func(d []byte) { d[i] = v })
+		result, err := im.decode(seal(d))
+		if err == nil {
+			err = radixt.Validate(result)
+		}
+
+		if err == nil {
+			exercise(result)
+			continue
+		}
+
+		if !isDecodingError(err) {
+			t.Errorf(
+				testCorruptedError,
+				im.name,
+				i,
+				v,
+				err,
+				serial.ErrorCorrupted,
+			)
+		}
+	}
+}
+
+func isDecodingError(err error) bool {
+	for _, e := range decodingErrors {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exercise visits all the nodes and looks up all the keys of tree t, which
+// should not panic for a tree, accepted by decoding.
+func exercise(t radixt.Tree) {
+	l := lookup.New(t)
+	keys.Each(t, func(key []byte, v uint) bool {
+		l.Reset()
+		for _, b := range key {
+			l.Feed(b)
+		}
+
+		l.Value()
+		return false
+	})
+
+	_ = evident.New(t)
+
+	if p, ok := t.(radixt.Parenter); ok {
+		for n := uint(0); n <= t.Size(); n++ {
+			p.Parent(n)
+		}
+	}
+}
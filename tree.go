@@ -14,6 +14,8 @@ package radixt
 //     node must have non-empty chunk).
 //  6. First bytes of children chunks should be unique over every parent node.
 //
+// Function [Validate] checks, if an implementation serves the contract.
+//
 // The interface does not put any limitations on values besides its domain of
 // unsigned integers. User is responsible for tree being static enough to use,
 // unless it is explicitly stated in documentation of tree implementation.
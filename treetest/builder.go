@@ -0,0 +1,20 @@
+package treetest
+
+import "github.com/alex-ilchukov/radixt"
+
+// Builder adapts constructor f of implementation, which returns the tree of
+// concrete type T, to build function of [Conformance]. The resulting function
+// returns nil tree on error of f, so the tree is nil interface then, not the
+// interface with nil pointer.
+func Builder[T radixt.Tree](f func(radixt.Tree) (T, error)) func(
+	radixt.Tree,
+) (radixt.Tree, error) {
+	return func(t radixt.Tree) (radixt.Tree, error) {
+		result, err := f(t)
+		if err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}
+}
@@ -0,0 +1,294 @@
+package treetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/keys"
+	"github.com/alex-ilchukov/radixt/lookup"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+const (
+	errorKeyMissing = "key %q is missing"
+	errorKeyValue   = "key %q has value %d (should be %d)"
+	errorKeyExtra   = "key %q is extra"
+	errorLookup     = "lookup of key %q got %d and %t " +
+		"(should be %d and true)"
+	errorStructure     = "structure is %v (should be %v)"
+	errorEmptyChunk    = "node %d has empty chunk"
+	errorOutsideValue  = "node %d out of tree has value %d, %t"
+	errorOutsideChunk  = "node %d out of tree has chunk %q"
+	errorOutsideChild  = "node %d out of tree has child %d"
+	errorOutsideSwitch = "node %d out of tree switches to %d, %q, %t"
+	errorChildOutside  = "node %d has child %d out of tree"
+	errorChildrenOrder = "node %d has children out of order"
+	errorStop          = "node %d did not stop on child %d (%d calls)"
+	errorSwitch        = "node %d switches on %q to %d, %q, %t"
+	errorHoardHint     = "hoard has invalid hint %d"
+	errorHoardZero     = "hoard of non-empty tree is zero"
+	errorHoardStable   = "hoard is not stable: %d, %d and %d, %d"
+	errorValidate      = "validate: %v"
+)
+
+// Conformance runs the conformance test suite for implementation of
+// [radixt.Tree], which is created by function build from the provided tree.
+// Every tree shape of the suite is tested in its own subtest. If build
+// returns one of overflow errors of package [compact] for a shape, the subtest
+// is skipped, as the implementation is considered unable to represent the
+// shape. Any other error of build fails the subtest.
+func Conformance(
+	t *testing.T,
+	build func(radixt.Tree) (radixt.Tree, error),
+) {
+	t.Helper()
+
+	t.Run("nil", func(t *testing.T) {
+		check(t, nil, build)
+	})
+
+	for _, s := range sources {
+		sv := s.sv
+		t.Run(s.name, func(t *testing.T) {
+			check(t, sapling.NewFromSV(sv...), build)
+		})
+	}
+}
+
+func check(
+	t *testing.T,
+	original radixt.Tree,
+	build func(radixt.Tree) (radixt.Tree, error),
+) {
+	tree, err := build(original)
+	switch {
+	case overflow(err):
+		t.Skipf("build: %v", err)
+	case err != nil:
+		t.Fatalf("build: %v", err)
+	}
+
+	if err := radixt.Validate(tree); err != nil {
+		t.Fatalf(errorValidate, err)
+	}
+
+	checkKeys(t, original, tree)
+	checkStructure(t, original, tree)
+	checkOutside(t, tree)
+	checkChildren(t, tree)
+	checkSwitch(t, tree)
+	checkHoard(t, tree)
+}
+
+func overflow(err error) bool {
+	return errors.Is(err, compact.ErrorOverflow) ||
+		errors.Is(err, compact.ErrorNodesOverflow) ||
+		errors.Is(err, compact.ErrorChunksOverflow)
+}
+
+type kv map[string]uint
+
+func collect(t radixt.Tree) kv {
+	result := make(kv)
+	keys.Each(t, func(key []byte, v uint) bool {
+		result[string(key)] = v
+		return false
+	})
+
+	return result
+}
+
+func checkKeys(t *testing.T, original, tree radixt.Tree) {
+	expected := collect(original)
+	result := collect(tree)
+	for key, v := range expected {
+		w, ok := result[key]
+		switch {
+		case !ok:
+			t.Errorf(errorKeyMissing, key)
+		case v != w:
+			t.Errorf(errorKeyValue, key, w, v)
+		}
+	}
+
+	for key := range result {
+		if _, ok := expected[key]; !ok {
+			t.Errorf(errorKeyExtra, key)
+		}
+	}
+
+	l := lookup.New(tree)
+	for key, v := range expected {
+		l.Reset()
+		for i := 0; i < len(key); i++ {
+			l.Feed(key[i])
+		}
+
+		if w, found := l.Value(); !found || v != w {
+			t.Errorf(
+				errorLookup,
+				key,
+				w,
+				found,
+				v,
+			)
+		}
+	}
+}
+
+func checkStructure(t *testing.T, original, tree radixt.Tree) {
+	if original == nil {
+		original = sapling.New()
+	}
+
+	if !evident.New(tree).Eq(original) {
+		t.Errorf(
+			errorStructure,
+			evident.New(tree),
+			evident.New(original),
+		)
+	}
+
+	for n := uint(0); n < tree.Size(); n++ {
+		if n > 0 && tree.Chunk(n) == "" {
+			t.Errorf(errorEmptyChunk, n)
+		}
+	}
+}
+
+func checkOutside(t *testing.T, tree radixt.Tree) {
+	size := tree.Size()
+	s, _ := tree.(lookup.Switcher)
+	for _, n := range []uint{size, size + 1, size + 256, ^uint(0)} {
+		if v, has := tree.Value(n); v != 0 || has {
+			t.Errorf(errorOutsideValue, n, v, has)
+		}
+
+		if c := tree.Chunk(n); c != "" {
+			t.Errorf(errorOutsideChunk, n, c)
+		}
+
+		tree.EachChild(n, func(c uint) bool {
+			t.Errorf(errorOutsideChild, n, c)
+			return false
+		})
+
+		if s == nil {
+			continue
+		}
+
+		for b := 0; b < 256; b++ {
+			c, chunk, found := s.Switch(n, byte(b))
+			if c != 0 || chunk != "" || found {
+				t.Errorf(
+					errorOutsideSwitch,
+					n,
+					c,
+					chunk,
+					found,
+				)
+			}
+		}
+	}
+}
+
+func children(tree radixt.Tree, n uint) (result []uint) {
+	tree.EachChild(n, func(c uint) bool {
+		result = append(result, c)
+		return false
+	})
+
+	return
+}
+
+func checkChildren(t *testing.T, tree radixt.Tree) {
+	size := tree.Size()
+	for n := uint(0); n < size; n++ {
+		cs := children(tree, n)
+		for i, c := range cs {
+			switch {
+			case c >= size:
+				t.Errorf(errorChildOutside, n, c)
+			case i > 0 && c <= cs[i-1]:
+				t.Errorf(errorChildrenOrder, n)
+			}
+		}
+
+		for k := 1; k <= len(cs); k++ {
+			calls := 0
+			tree.EachChild(n, func(uint) bool {
+				calls++
+				return calls == k
+			})
+
+			if calls != k {
+				t.Errorf(
+					errorStop,
+					n,
+					k,
+					calls,
+				)
+			}
+		}
+	}
+}
+
+func checkSwitch(t *testing.T, tree radixt.Tree) {
+	s, ok := tree.(lookup.Switcher)
+	if !ok {
+		return
+	}
+
+	for n := uint(0); n < tree.Size(); n++ {
+		var expected [256]uint
+		var has [256]bool
+		for _, c := range children(tree, n) {
+			if chunk := tree.Chunk(c); chunk != "" {
+				expected[chunk[0]] = c
+				has[chunk[0]] = true
+			}
+		}
+
+		for b := 0; b < 256; b++ {
+			c, chunk, found := s.Switch(n, byte(b))
+			e := found != has[b] ||
+				c != expected[b] ||
+				(found && chunk != tree.Chunk(c)[1:]) ||
+				(!found && chunk != "")
+
+			if e {
+				t.Errorf(
+					errorSwitch,
+					n,
+					byte(b),
+					c,
+					chunk,
+					found,
+				)
+			}
+		}
+	}
+}
+
+func checkHoard(t *testing.T, tree radixt.Tree) {
+	h, ok := tree.(radixt.Hoarder)
+	if !ok {
+		return
+	}
+
+	amount, hint := h.Hoard()
+	switch {
+	case hint != radixt.HoardExactly && hint != radixt.HoardAtLeast:
+		t.Errorf(errorHoardHint, hint)
+
+	case tree.Size() > 0 && amount == 0:
+		t.Errorf(errorHoardZero)
+	}
+
+	if a, h := h.Hoard(); a != amount || h != hint {
+		t.Errorf(errorHoardStable, amount, hint, a, h)
+	}
+}
@@ -0,0 +1,11 @@
+// Package treetest provides conformance test suite for implementations of
+// [radixt.Tree] interface.
+//
+// The suite builds trees of various shapes with the implementation in test
+// and verifies their behaviour: keys and values, chunks, order of children,
+// early stop of enumeration of children, results for nodes out of the tree,
+// consistency of [lookup.Switcher] with enumeration of children, if the
+// implementation provides it, and sanity of [radixt.Hoarder]. Every built
+// tree is also checked with [radixt.Validate] first, so a tree, which violates
+// the contract of the interface, fails the subtest.
+package treetest
@@ -0,0 +1,83 @@
+package treetest
+
+import (
+	"strings"
+
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+type source struct {
+	name string
+	sv   []sapling.SV
+}
+
+func numbered(ss ...string) []sapling.SV {
+	sv := make([]sapling.SV, len(ss))
+	for i, s := range ss {
+		sv[i] = sapling.SV{S: s, V: uint(i)}
+	}
+
+	return sv
+}
+
+func siblings() []sapling.SV {
+	sv := make([]sapling.SV, 256)
+	for i := range sv {
+		sv[i] = sapling.SV{S: string([]byte{byte(255 - i)}), V: uint(i)}
+	}
+
+	return sv
+}
+
+var sources = []source{
+	{name: "empty", sv: nil},
+	{name: "blank", sv: numbered("")},
+	{name: "single", sv: numbered("a")},
+	{name: "chain", sv: numbered("", "a", "ab", "abc", "abcd")},
+	{
+		name: "headers",
+		sv: numbered(
+			"authority",
+			"authorization",
+			"author",
+			"authentication",
+			"auth",
+			"content-type",
+			"content-length",
+			"content-disposition",
+		),
+	},
+	{
+		name: "methods",
+		sv: numbered(
+			"GET",
+			"POST",
+			"PATCH",
+			"DELETE",
+			"PUT",
+			"OPTIONS",
+			"CONNECT",
+			"HEAD",
+			"TRACE",
+		),
+	},
+	{
+		name: "values",
+		sv: []sapling.SV{
+			{S: "zero", V: 0},
+			{S: "one", V: 1},
+			{S: "thousand", V: 1000},
+			{S: "one hundred", V: 100},
+			{S: "on", V: 7},
+		},
+	},
+	{name: "siblings", sv: siblings()},
+	{
+		name: "long",
+		sv: numbered(
+			strings.Repeat("abcdefgh", 40),
+			strings.Repeat("abcdefgh", 40)+"!",
+			strings.Repeat("abcdefgh", 20)+"\x00\xFF",
+		),
+	},
+}
@@ -0,0 +1,240 @@
+package radixt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorContract is wrapped by every [ContractError], so errors.Is can be used
+// to check, if a tree violates the contract.
+var ErrorContract = errors.New("tree violates contract")
+
+// Violation describes a violation of the contract of [Tree] interface. Line is
+// number of the contract line, which is violated, or zero, if behaviour of a
+// method violates its description. Node is index of the node, which is at
+// fault.
+type Violation struct {
+	Node   uint
+	Line   int
+	Reason string
+}
+
+// String returns description of the violation.
+func (v Violation) String() string {
+	if v.Line == 0 {
+		return fmt.Sprintf("node %d: %s", v.Node, v.Reason)
+	}
+
+	return fmt.Sprintf("node %d: line %d: %s", v.Node, v.Line, v.Reason)
+}
+
+// ContractError is returned by [Validate] and contains all the violations of
+// the contract, found in a tree.
+type ContractError struct {
+	Violations []Violation
+}
+
+// Error returns description of all the violations.
+func (e *ContractError) Error() string {
+	var b strings.Builder
+	b.WriteString(ErrorContract.Error())
+	for i, v := range e.Violations {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+
+		b.WriteString(v.String())
+	}
+
+	return b.String()
+}
+
+// Unwrap returns [ErrorContract].
+func (e *ContractError) Unwrap() error {
+	return ErrorContract
+}
+
+// Validate walks tree t once and checks, that it serves the contract of [Tree]
+// interface. Besides the contract lines, it checks, that every node, but the
+// root, has exactly one parent and is reachable from the root, that children
+// are enumerated in ascending order and just once, that enumeration stops,
+// when the user function returns boolean truth, and that the methods return
+// default values for indices out of the tree. If t implements [Parenter], it
+// also checks, that parents of nodes agree with enumeration of children. Nil
+// values of t are supported and interpreted as empty tree. It returns nil, if
+// no violations are found, or [*ContractError] with all of them otherwise.
+func Validate(t Tree) error {
+	if t == nil {
+		return nil
+	}
+
+	v := validator{t: t, size: t.Size()}
+	v.outside()
+
+	v.parents = make([]uint, v.size)
+	v.children = make([][]uint, v.size)
+	for n := uint(0); n < v.size; n++ {
+		v.node(n)
+	}
+
+	v.reachability()
+	v.parenter()
+
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	return &ContractError{Violations: v.violations}
+}
+
+type validator struct {
+	t          Tree
+	size       uint
+	parents    []uint
+	children   [][]uint
+	violations []Violation
+}
+
+func (v *validator) report(n uint, line int, format string, args ...any) {
+	v.violations = append(v.violations, Violation{
+		Node:   n,
+		Line:   line,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) outside() {
+	n := v.size
+	if value, has := v.t.Value(n); value != 0 || has {
+		v.report(n, 0, "value of node out of the tree")
+	}
+
+	if v.t.Chunk(n) != "" {
+		v.report(n, 0, "chunk of node out of the tree")
+	}
+
+	v.t.EachChild(n, func(uint) bool {
+		v.report(n, 0, "children of node out of the tree")
+		return true
+	})
+
+	if p, ok := v.t.(Parenter); ok {
+		if parent, has := p.Parent(n); parent != 0 || has {
+			v.report(n, 0, "parent of node out of the tree")
+		}
+	}
+}
+
+func (v *validator) node(n uint) {
+	var firsts [256]bool
+	var prev uint
+	calls := 0
+	v.t.EachChild(n, func(c uint) bool {
+		calls++
+		switch {
+		case calls > 1 && c <= prev:
+			v.report(n, 0, "child %d does not ascend", c)
+
+		case c >= v.size:
+			v.report(n, 2, "child %d is out of the tree", c)
+
+		case c <= n:
+			v.report(n, 4, "child %d is not greater than parent", c)
+
+		default:
+			v.child(n, c, &firsts)
+		}
+
+		prev = c
+		return false
+	})
+
+	if calls > 1 {
+		stopped := 0
+		v.t.EachChild(n, func(uint) bool {
+			stopped++
+			return true
+		})
+
+		if stopped != 1 {
+			v.report(n, 0, "enumeration of children does not stop")
+		}
+	}
+}
+
+func (v *validator) child(n, c uint, firsts *[256]bool) {
+	v.parents[c]++
+	if v.parents[c] == 2 {
+		v.report(c, 0, "node has several parents")
+	}
+
+	v.children[n] = append(v.children[n], c)
+
+	chunk := v.t.Chunk(c)
+	if chunk == "" {
+		v.report(c, 5, "child has empty chunk")
+		return
+	}
+
+	if firsts[chunk[0]] {
+		v.report(c, 6, "first byte %q is not unique", chunk[0])
+	}
+
+	firsts[chunk[0]] = true
+}
+
+func (v *validator) reachability() {
+	if v.size == 0 {
+		return
+	}
+
+	reached := make([]bool, v.size)
+	reached[0] = true
+	queue := []uint{0}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, c := range v.children[n] {
+			if !reached[c] {
+				reached[c] = true
+				queue = append(queue, c)
+			}
+		}
+	}
+
+	for n, r := range reached {
+		if !r {
+			v.report(uint(n), 3, "node is unreachable from root")
+		}
+	}
+}
+
+// parenter checks, that method Parent of the tree, if it is implemented,
+// returns no parent for the root and the enumerating parent for any node,
+// which has only one.
+func (v *validator) parenter() {
+	p, ok := v.t.(Parenter)
+	if !ok || v.size == 0 {
+		return
+	}
+
+	if parent, has := p.Parent(0); has {
+		v.report(0, 0, "root has parent %d", parent)
+	}
+
+	for n, children := range v.children {
+		for _, c := range children {
+			if v.parents[c] != 1 {
+				continue
+			}
+
+			parent, has := p.Parent(c)
+			if !has || parent != uint(n) {
+				v.report(c, 0, "parent %d is not found", n)
+			}
+		}
+	}
+}
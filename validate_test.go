@@ -0,0 +1,228 @@
+package radixt_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alex-ilchukov/radixt"
+	"github.com/alex-ilchukov/radixt/compact/str3"
+	"github.com/alex-ilchukov/radixt/compact/str4"
+	"github.com/alex-ilchukov/radixt/compact/strg"
+	"github.com/alex-ilchukov/radixt/compact/struct32"
+	"github.com/alex-ilchukov/radixt/compact/struct64"
+	"github.com/alex-ilchukov/radixt/compact/structg"
+	"github.com/alex-ilchukov/radixt/evident"
+	"github.com/alex-ilchukov/radixt/generic"
+	"github.com/alex-ilchukov/radixt/null"
+	"github.com/alex-ilchukov/radixt/sapling"
+)
+
+// tree is a naive implementation of radixt.Tree, which can violate the
+// contract in any possible way.
+type tree struct {
+	chunks   []string
+	children [][]uint
+	stopless bool
+	outside  bool
+}
+
+func (t *tree) Size() uint {
+	return uint(len(t.chunks))
+}
+
+func (t *tree) Value(n uint) (uint, bool) {
+	if n < t.Size() || t.outside {
+		return n, true
+	}
+
+	return 0, false
+}
+
+func (t *tree) Chunk(n uint) string {
+	if n < t.Size() {
+		return t.chunks[n]
+	}
+
+	return ""
+}
+
+func (t *tree) EachChild(n uint, e func(uint) bool) {
+	if n >= t.Size() {
+		return
+	}
+
+	for _, c := range t.children[n] {
+		if e(c) && !t.stopless {
+			return
+		}
+	}
+}
+
+// parented is the naive implementation with parents, which can disagree with
+// enumeration of children. Negative parents mean absence of parent.
+type parented struct {
+	tree
+	parents []int
+}
+
+func (t *parented) Parent(n uint) (uint, bool) {
+	if n < uint(len(t.parents)) && t.parents[n] >= 0 {
+		return uint(t.parents[n]), true
+	}
+
+	return 0, false
+}
+
+var keys = sapling.New(
+	"authority",
+	"authorization",
+	"author",
+	"authentication",
+	"auth",
+	"content-type",
+	"content-length",
+	"content-disposition",
+)
+
+var validateValidTests = []radixt.Tree{
+	nil,
+	null.Tree,
+	sapling.New(),
+	sapling.New("a", "b"),
+	evident.New(keys),
+	generic.New(keys),
+	str3.MustCreate(keys),
+	str4.MustCreate(keys),
+	strg.MustCreate[strg.N3](keys),
+	strg.MustCreate[strg.N4](keys),
+	struct32.MustCreate(keys),
+	struct64.MustCreate(keys),
+	structg.MustCreate[uint32](keys),
+	structg.MustCreate[uint64](keys),
+	&tree{
+		chunks:   []string{"", "a", "b"},
+		children: [][]uint{{1, 2}, nil, nil},
+	},
+	&parented{
+		tree: tree{
+			chunks:   []string{"", "a", "b"},
+			children: [][]uint{{1}, {2}, nil},
+		},
+		parents: []int{-1, 0, 1},
+	},
+}
+
+const testValidateValidError = "Validate Valid Test %d: got error %v"
+
+func TestValidateValid(t *testing.T) {
+	for i, tt := range validateValidTests {
+		if err := radixt.Validate(tt); err != nil {
+			t.Errorf(testValidateValidError, i, err)
+		}
+	}
+}
+
+func v(n uint, line int, reason string) radixt.Violation {
+	return radixt.Violation{Node: n, Line: line, Reason: reason}
+}
+
+var validateInvalidTests = []struct {
+	tree       radixt.Tree
+	violations []radixt.Violation
+}{
+	{
+		tree: &tree{
+			chunks:   []string{"", "a", "b", "c"},
+			children: [][]uint{{2, 1, 4}, {0}, nil, nil},
+		},
+		violations: []radixt.Violation{
+			v(0, 0, "child 1 does not ascend"),
+			v(0, 2, "child 4 is out of the tree"),
+			v(1, 4, "child 0 is not greater than parent"),
+			v(1, 3, "node is unreachable from root"),
+			v(3, 3, "node is unreachable from root"),
+		},
+	},
+	{
+		tree: &tree{
+			chunks:   []string{"", "a", "", "ab", "c"},
+			children: [][]uint{{1, 2, 3}, {3, 4}, nil, nil, nil},
+			stopless: true,
+			outside:  true,
+		},
+		violations: []radixt.Violation{
+			v(5, 0, "value of node out of the tree"),
+			v(2, 5, "child has empty chunk"),
+			v(3, 6, "first byte 'a' is not unique"),
+			v(0, 0, "enumeration of children does not stop"),
+			v(3, 0, "node has several parents"),
+			v(1, 0, "enumeration of children does not stop"),
+		},
+	},
+	{
+		tree: &parented{
+			tree: tree{
+				chunks:   []string{"", "a", "b", "c"},
+				children: [][]uint{{1, 2}, {3}, nil, nil},
+			},
+			parents: []int{2, 0, -1, 0, 3},
+		},
+		violations: []radixt.Violation{
+			v(4, 0, "parent of node out of the tree"),
+			v(0, 0, "root has parent 2"),
+			v(2, 0, "parent 0 is not found"),
+			v(3, 0, "parent 1 is not found"),
+		},
+	},
+}
+
+const testValidateSaplingError = "Validate Sapling Test: got error %v " +
+	"(should be violation of line 4)"
+
+// TestValidateSapling verifies, that validation catches indices of sapling
+// trees, which follow order of creation of nodes, but not contract line 4.
+func TestValidateSapling(t *testing.T) {
+	err := radixt.Validate(keys)
+
+	var ce *radixt.ContractError
+	if !errors.As(err, &ce) || ce.Violations[0].Line != 4 {
+		t.Errorf(testValidateSaplingError, err)
+	}
+}
+
+const testValidateInvalidError = "Validate Invalid Test %d: got error %v " +
+	"(should be with %v)"
+
+func TestValidateInvalid(t *testing.T) {
+	for i, tt := range validateInvalidTests {
+		err := radixt.Validate(tt.tree)
+
+		var ce *radixt.ContractError
+		e := !errors.As(err, &ce) ||
+			!errors.Is(err, radixt.ErrorContract) ||
+			!eqViolations(ce.Violations, tt.violations)
+
+		if e {
+			t.Errorf(
+				testValidateInvalidError,
+				i,
+				err,
+				tt.violations,
+			)
+		}
+	}
+}
+
+func eqViolations(a, b []radixt.Violation) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}